@@ -0,0 +1,164 @@
+/*
+Author: Junior ADI
+Description: Comparison of two sets of benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Comparison compares the measurements of one algorithm on one input in two
+// sets of results.
+type Comparison struct {
+	Algorithm    string
+	Size         int
+	Distribution string
+
+	// OldNs and NewNs are the median times of a call.
+	OldNs, NewNs float64
+	// OldRuns and NewRuns are the number of samples.
+	OldRuns, NewRuns int
+	// Delta is the relative change of the median time, negative when the
+	// new results are faster.
+	Delta float64
+	// P is the p-value of the Mann-Whitney U test of the samples, NaN when
+	// one of the results has no samples.
+	P float64
+}
+
+// Significant reports whether the change is significant at level alpha.
+func (c Comparison) Significant(alpha float64) bool {
+	return !math.IsNaN(c.P) && c.P < alpha
+}
+
+// Regression reports whether the new results are significantly slower at
+// level alpha, by more than threshold relative to the old ones.
+func (c Comparison) Regression(threshold, alpha float64) bool {
+	return c.Significant(alpha) && c.Delta > threshold
+}
+
+// compareKey identifies the measurements of one algorithm on one input.
+type compareKey struct {
+	algorithm    string
+	size         int
+	distribution string
+}
+
+func (k compareKey) String() string {
+	return fmt.Sprintf("%s/size=%d/dist=%s", k.algorithm, k.size, k.distribution)
+}
+
+// pooledSamples returns the samples of the measured records grouped by
+// algorithm and input, with the keys in the order they first appear. A group
+// without raw samples is given the medians of its records instead. The
+// projected and skipped records are left out, and so are the failed records
+// without any run.
+func pooledSamples(records []Record) (map[compareKey][]float64, []compareKey) {
+	samples := make(map[compareKey][]float64)
+	medians := make(map[compareKey][]float64)
+	var keys []compareKey
+	for _, record := range records {
+		if record.Projected || record.Skipped != "" || record.Failure != "" && record.Runs == 0 {
+			continue
+		}
+		k := compareKey{record.Algorithm, record.Size, record.Distribution}
+		if _, ok := medians[k]; !ok {
+			keys = append(keys, k)
+		}
+		medians[k] = append(medians[k], float64(record.Ns))
+		for _, sample := range record.SamplesNs {
+			samples[k] = append(samples[k], float64(sample))
+		}
+	}
+	for _, k := range keys {
+		if len(samples[k]) == 0 {
+			samples[k] = medians[k]
+		}
+	}
+	return samples, keys
+}
+
+// hasSamples reports whether every record of the algorithm and input of k
+// has raw samples.
+func hasSamples(records []Record, k compareKey) bool {
+	for _, record := range records {
+		if (compareKey{record.Algorithm, record.Size, record.Distribution}) == k && len(record.SamplesNs) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare matches the algorithm, size and distribution of the old records,
+// before a change, and of the new ones, after it, and compares their
+// samples, pooling the samples of repeated records. It returns the
+// comparisons sorted by algorithm, distribution and size, and the keys of the
+// measurements found in only one of the sets.
+func Compare(before, after []Record) ([]Comparison, []string) {
+	beforeSamples, beforeKeys := pooledSamples(before)
+	afterSamples, afterKeys := pooledSamples(after)
+
+	var comparisons []Comparison
+	var unmatched []string
+	for _, k := range beforeKeys {
+		newSamples, ok := afterSamples[k]
+		if !ok {
+			unmatched = append(unmatched, k.String()+" (old only)")
+			continue
+		}
+		oldSamples := beforeSamples[k]
+		c := Comparison{
+			Algorithm:    k.algorithm,
+			Size:         k.size,
+			Distribution: k.distribution,
+			OldNs:        median(oldSamples),
+			NewNs:        median(newSamples),
+			OldRuns:      len(oldSamples),
+			NewRuns:      len(newSamples),
+			P:            math.NaN(),
+		}
+		if c.OldNs > 0 {
+			c.Delta = c.NewNs/c.OldNs - 1
+		}
+		if hasSamples(before, k) && hasSamples(after, k) {
+			_, c.P = MannWhitneyU(oldSamples, newSamples)
+		}
+		comparisons = append(comparisons, c)
+	}
+	for _, k := range afterKeys {
+		if _, ok := beforeSamples[k]; !ok {
+			unmatched = append(unmatched, k.String()+" (new only)")
+		}
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		a, b := comparisons[i], comparisons[j]
+		if a.Algorithm != b.Algorithm {
+			return a.Algorithm < b.Algorithm
+		}
+		if a.Distribution != b.Distribution {
+			return a.Distribution < b.Distribution
+		}
+		return a.Size < b.Size
+	})
+	return comparisons, unmatched
+}
+
+// median returns the median of samples.
+func median(samples []float64) float64 {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	return quantile(sorted, 0.5)
+}
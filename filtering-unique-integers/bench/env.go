@@ -0,0 +1,63 @@
+/*
+Author: Junior ADI
+Description: Environment metadata of the benchmark runs
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"bufio"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Environment describes the machine and the Go runtime of a benchmark run.
+type Environment struct {
+	GoVersion  string    `json:"go_version"`
+	GOOS       string    `json:"goos"`
+	GOARCH     string    `json:"goarch"`
+	GOMAXPROCS int       `json:"gomaxprocs"`
+	CPUModel   string    `json:"cpu_model"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// CurrentEnvironment returns the environment of the running program, with
+// the current time as timestamp.
+func CurrentEnvironment() Environment {
+	return Environment{
+		GoVersion:  runtime.Version(),
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		CPUModel:   cpuModel(),
+		Timestamp:  time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// cpuModel returns the CPU model name found in /proc/cpuinfo, or "unknown"
+// where it is not available.
+func cpuModel() string {
+	file, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return "unknown"
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if ok && strings.TrimSpace(key) == "model name" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return "unknown"
+}
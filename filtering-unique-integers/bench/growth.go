@@ -0,0 +1,76 @@
+/*
+Author: Junior ADI
+Description: Growth curves projecting the time of the filters
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// maxGrowthPoints is the number of largest sizes FitGrowth fits the curve
+// to. The smaller sizes are dominated by constant costs that would flatten
+// the curve.
+const maxGrowthPoints = 4
+
+// Growth is the power law Coef * size^Exp nanoseconds fitted to the times of
+// an algorithm.
+type Growth struct {
+	Coef float64
+	Exp  float64
+}
+
+// At returns the projected time of a call at size, in nanoseconds.
+func (g Growth) At(size int) float64 {
+	return g.Coef * math.Pow(float64(size), g.Exp)
+}
+
+// FitGrowth fits a power law to the times ns, in nanoseconds, measured at
+// sizes. The exponent is the least squares slope of log(ns) against
+// log(size) over the largest sizes, kept between 0 and 3. With a single
+// size, the curve goes through it with the exponent exp, typically the
+// complexity of the algorithm.
+func FitGrowth(sizes []int, ns []float64, exp float64) (Growth, error) {
+	type point struct{ x, y float64 }
+	var points []point
+	for i, size := range sizes {
+		if size > 0 && ns[i] > 0 {
+			points = append(points, point{math.Log(float64(size)), math.Log(ns[i])})
+		}
+	}
+	if len(points) == 0 {
+		return Growth{}, errors.New("bench: no positive time to fit a growth curve to")
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].x < points[j].x })
+	if len(points) > maxGrowthPoints {
+		points = points[len(points)-maxGrowthPoints:]
+	}
+
+	var meanX, meanY float64
+	for _, p := range points {
+		meanX += p.x
+		meanY += p.y
+	}
+	meanX /= float64(len(points))
+	meanY /= float64(len(points))
+	var sxx, sxy float64
+	for _, p := range points {
+		sxx += (p.x - meanX) * (p.x - meanX)
+		sxy += (p.x - meanX) * (p.y - meanY)
+	}
+	if sxx > 0 {
+		exp = math.Min(math.Max(sxy/sxx, 0), 3)
+	}
+	return Growth{Coef: math.Exp(meanY - exp*meanX), Exp: exp}, nil
+}
@@ -0,0 +1,340 @@
+/*
+Author: Junior ADI
+Description: Import of the legacy text benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The lines of the benchmark_results.txt format, written by WriteText.
+var (
+	textSizeLine       = regexp.MustCompile(`^Benchmark for array size (\d+)$`)
+	textAlgorithmLine  = regexp.MustCompile(`^Benchmark for (.+) algorithm$`)
+	textInputLine      = regexp.MustCompile(`^Input: distribution=(\S+) seed=(\d+)$`)
+	textTimeLine       = regexp.MustCompile(`^Execution time: (.+)$`)
+	textStatisticsLine = regexp.MustCompile(`^Statistics: min=(\S+) median=(\S+) mean=(\S+) p95=(\S+) stddev=(\S+) ci95=\[(\S+), (\S+)\] runs=(\d+) rejected=(\d+)$`)
+	textAllocsLine     = regexp.MustCompile(`^Allocations: (\d+) allocs/op, (\d+) B/op$`)
+	textFailureLine    = regexp.MustCompile(`^Incorrect output: (.+)$`)
+	textSkippedLine    = regexp.MustCompile(`^Skipped: (.+)$`)
+	textDateLine       = regexp.MustCompile(`^Date: (.+)$`)
+)
+
+// The lines of the benchmark_best_results.txt format, written by
+// benchmark-analysis.py.
+var (
+	bestSizeLine = regexp.MustCompile(`^Array size: (\d+)$`)
+	bestTimeLine = regexp.MustCompile(`^(.+) algorithm: (.+)$`)
+)
+
+// legacyDistribution returns the input distribution of the legacy benchmark
+// at size: the fixed sample array at size 10, random values otherwise.
+func legacyDistribution(size int) string {
+	if size == 10 {
+		return "sample"
+	}
+	return "random"
+}
+
+// ParseLegacyTime parses a time of the legacy formats into nanoseconds. It
+// accepts Go durations such as "3.612598078s" or "1m2.5s", with "µs", "μs"
+// or "us" for microseconds, and numbers with an exponent such as
+// "1e-05µs" as printed by Python.
+func ParseLegacyTime(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "-") {
+		return 0, fmt.Errorf("negative time %q", s)
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return int64(d), nil
+	}
+	units := []struct {
+		name  string
+		scale float64
+	}{{"ns", 1}, {"us", 1e3}, {"µs", 1e3}, {"μs", 1e3}, {"ms", 1e6}, {"s", 1e9}}
+	for _, unit := range units {
+		number, ok := strings.CutSuffix(s, unit.name)
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			break
+		}
+		return int64(value*unit.scale + 0.5), nil
+	}
+	return 0, fmt.Errorf("invalid time %q", s)
+}
+
+// legacyRecord returns the record of a single legacy timing.
+func legacyRecord(algorithm string, size int, ns int64, env Environment) Record {
+	return Record{
+		Algorithm:    algorithm,
+		Size:         size,
+		Distribution: legacyDistribution(size),
+		Ns:           ns,
+		MinNs:        ns,
+		MeanNs:       ns,
+		P95Ns:        ns,
+		CILowNs:      ns,
+		CIHighNs:     ns,
+		Runs:         1,
+		Environment:  env,
+	}
+}
+
+// ReadLegacy reads the records of a legacy text file, either in the
+// "Benchmark for array size N" format of benchmark_results.txt or in the
+// "Array size: N" format of benchmark_best_results.txt, detected from the
+// first line. The legacy runs timed a single call on the sample array at
+// size 10 and on random values otherwise, so the records have one run and
+// the "sample" or "random" distribution, unless an "Input:" line gives the
+// distribution and seed.
+//
+// Besides the records, it returns warnings about suspicious data, such as
+// an algorithm with the same time at every size or the units of a
+// benchmark_best_results.txt file.
+func ReadLegacy(r io.Reader) ([]Record, []string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	var first string
+	for _, line := range lines {
+		if line != "" {
+			first = line
+			break
+		}
+	}
+
+	var records []Record
+	var warnings []string
+	var err error
+	switch {
+	case first == "Benchmark results" || textSizeLine.MatchString(first):
+		records, warnings, err = readLegacyText(lines)
+	case bestSizeLine.MatchString(first):
+		records, warnings, err = readLegacyBest(lines)
+	default:
+		return nil, nil, fmt.Errorf("unknown legacy format, first line %q", first)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, append(warnings, checkRepeatedTimes(records)...), nil
+}
+
+// readLegacyText reads the lines of the benchmark_results.txt format.
+func readLegacyText(lines []string) ([]Record, []string, error) {
+	var records []Record
+	var warnings []string
+	var env Environment
+	size := -1
+	// pending tells whether the last record still waits for its execution
+	// time.
+	pending := false
+	seen := make(map[string]bool)
+	for i, line := range lines {
+		lineNo := i + 1
+		switch {
+		case line == "" || line == "Benchmark results" || strings.HasPrefix(line, "Author:") || strings.Trim(line, "-") == "":
+		case textDateLine.MatchString(line):
+			date := textDateLine.FindStringSubmatch(line)[1]
+			timestamp, err := time.Parse(time.RFC3339, date)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: invalid date %q", lineNo, date)
+			}
+			env.Timestamp = timestamp
+		case textSizeLine.MatchString(line):
+			if pending {
+				return nil, nil, fmt.Errorf("line %d: no execution time for %s algorithm", lineNo, records[len(records)-1].Algorithm)
+			}
+			size, _ = strconv.Atoi(textSizeLine.FindStringSubmatch(line)[1])
+		case textAlgorithmLine.MatchString(line):
+			if size < 0 {
+				return nil, nil, fmt.Errorf("line %d: algorithm before any array size", lineNo)
+			}
+			if pending {
+				return nil, nil, fmt.Errorf("line %d: no execution time for %s algorithm", lineNo, records[len(records)-1].Algorithm)
+			}
+			algorithm := textAlgorithmLine.FindStringSubmatch(line)[1]
+			key := fmt.Sprintf("%s/%d", algorithm, size)
+			if seen[key] {
+				warnings = append(warnings, fmt.Sprintf("line %d: %s algorithm measured twice at array size %d", lineNo, algorithm, size))
+			}
+			seen[key] = true
+			records = append(records, legacyRecord(algorithm, size, 0, env))
+			pending = true
+		case textInputLine.MatchString(line):
+			if !pending {
+				return nil, nil, fmt.Errorf("line %d: input without algorithm", lineNo)
+			}
+			match := textInputLine.FindStringSubmatch(line)
+			seed, err := strconv.ParseUint(match[2], 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: invalid seed %q", lineNo, match[2])
+			}
+			records[len(records)-1].Distribution = match[1]
+			records[len(records)-1].Seed = seed
+		case textTimeLine.MatchString(line):
+			if !pending {
+				return nil, nil, fmt.Errorf("line %d: execution time without algorithm", lineNo)
+			}
+			value, projected := strings.CutSuffix(textTimeLine.FindStringSubmatch(line)[1], " (projected)")
+			ns, err := ParseLegacyTime(value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			record := &records[len(records)-1]
+			distribution, seed := record.Distribution, record.Seed
+			*record = legacyRecord(record.Algorithm, size, ns, env)
+			record.Distribution, record.Seed = distribution, seed
+			if projected {
+				record.Runs = 0
+				record.Projected = true
+			}
+			pending = false
+		case textSkippedLine.MatchString(line):
+			if !pending {
+				return nil, nil, fmt.Errorf("line %d: skipped without algorithm", lineNo)
+			}
+			records[len(records)-1].Skipped = textSkippedLine.FindStringSubmatch(line)[1]
+			records[len(records)-1].Runs = 0
+			pending = false
+		case textStatisticsLine.MatchString(line):
+			if len(records) == 0 {
+				return nil, nil, fmt.Errorf("line %d: statistics without algorithm", lineNo)
+			}
+			if err := parseStatistics(&records[len(records)-1], textStatisticsLine.FindStringSubmatch(line)[1:]); err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+		case textAllocsLine.MatchString(line):
+			if len(records) == 0 {
+				return nil, nil, fmt.Errorf("line %d: allocations without algorithm", lineNo)
+			}
+			match := textAllocsLine.FindStringSubmatch(line)
+			record := &records[len(records)-1]
+			record.Allocs, _ = strconv.ParseUint(match[1], 10, 64)
+			record.Bytes, _ = strconv.ParseUint(match[2], 10, 64)
+		case textFailureLine.MatchString(line):
+			if len(records) == 0 {
+				return nil, nil, fmt.Errorf("line %d: incorrect output without algorithm", lineNo)
+			}
+			records[len(records)-1].Failure = textFailureLine.FindStringSubmatch(line)[1]
+		default:
+			return nil, nil, fmt.Errorf("line %d: unexpected line %q", lineNo, line)
+		}
+	}
+	if pending {
+		return nil, nil, fmt.Errorf("no execution time for %s algorithm at array size %d", records[len(records)-1].Algorithm, size)
+	}
+	return records, warnings, nil
+}
+
+// parseStatistics sets the statistics of record from the fields of a
+// Statistics line: min, median, mean, p95, stddev, the bounds of the
+// confidence interval, runs and rejected.
+func parseStatistics(record *Record, fields []string) error {
+	times := []*int64{&record.MinNs, &record.Ns, &record.MeanNs, &record.P95Ns, &record.StdDevNs, &record.CILowNs, &record.CIHighNs}
+	for i, field := range fields[:len(times)] {
+		ns, err := ParseLegacyTime(field)
+		if err != nil {
+			return err
+		}
+		*times[i] = ns
+	}
+	record.Runs, _ = strconv.Atoi(fields[7])
+	record.Rejected, _ = strconv.Atoi(fields[8])
+	return nil
+}
+
+// bestUnitsWarning is the warning of every file of the
+// benchmark_best_results.txt format. benchmark-analysis.py cut the last two
+// characters of each time as its unit, converted "ms" and wrote every time
+// with "µs": a time of "861ns" became "861µs", 1000 times too large, and one
+// of "3.6797s" became "3.679µs", a million times too small and cut short.
+const bestUnitsWarning = "the times of benchmark-analysis.py are unreliable: it wrote every time in µs, so its times of the ns and s units are off by a factor of 1000 or more"
+
+// readLegacyBest reads the lines of the benchmark_best_results.txt format.
+// The times are read in the unit written, and the file always gets the
+// bestUnitsWarning, as that unit cannot be trusted.
+func readLegacyBest(lines []string) ([]Record, []string, error) {
+	var records []Record
+	size := -1
+	for i, line := range lines {
+		lineNo := i + 1
+		switch {
+		case line == "" || strings.Trim(line, "-") == "":
+		case bestSizeLine.MatchString(line):
+			size, _ = strconv.Atoi(bestSizeLine.FindStringSubmatch(line)[1])
+		case bestTimeLine.MatchString(line):
+			if size < 0 {
+				return nil, nil, fmt.Errorf("line %d: algorithm before any array size", lineNo)
+			}
+			match := bestTimeLine.FindStringSubmatch(line)
+			ns, err := ParseLegacyTime(match[2])
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			records = append(records, legacyRecord(match[1], size, ns, Environment{}))
+		default:
+			return nil, nil, fmt.Errorf("line %d: unexpected line %q", lineNo, line)
+		}
+	}
+	return records, []string{bestUnitsWarning}, nil
+}
+
+// checkRepeatedTimes warns about the algorithms with the same time at every
+// one of several array sizes. A real benchmark never times the same to the
+// nanosecond over a range of sizes: benchmark-analysis.py produced such
+// files by looking the time up with lines.index(line), which returns the
+// first "Benchmark for X algorithm" line of the file, so every size got the
+// times of the first one.
+func checkRepeatedTimes(records []Record) []string {
+	var algorithms []string
+	first := make(map[string]int64)
+	sizes := make(map[string]int)
+	repeated := make(map[string]bool)
+	for _, record := range records {
+		if record.Skipped != "" {
+			continue
+		}
+		ns, ok := first[record.Algorithm]
+		if !ok {
+			algorithms = append(algorithms, record.Algorithm)
+			first[record.Algorithm] = record.Ns
+			repeated[record.Algorithm] = true
+		} else if record.Ns != ns {
+			repeated[record.Algorithm] = false
+		}
+		sizes[record.Algorithm]++
+	}
+	var warnings []string
+	for _, algorithm := range algorithms {
+		if repeated[algorithm] && sizes[algorithm] >= 3 {
+			warnings = append(warnings, fmt.Sprintf("%s algorithm has the same time %v at all %d array sizes, probably copied from the first size",
+				algorithm, time.Duration(first[algorithm]), sizes[algorithm]))
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,206 @@
+package bench
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseLegacyTime(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		want int64
+	}{
+		{"4.172µs", 4172},
+		{"4.172μs", 4172},
+		{"4.172us", 4172},
+		{"861ns", 861},
+		{"6.400446ms", 6400446},
+		{"3.612598078s", 3612598078},
+		{"1m2.5s", 62500000000},
+		{"6400.446µs", 6400446},
+		{"1e-05s", 10000},
+	} {
+		got, err := ParseLegacyTime(test.in)
+		if err != nil || got != test.want {
+			t.Errorf("ParseLegacyTime(%q) = %d, %v, want %d", test.in, got, err, test.want)
+		}
+	}
+	for _, bad := range []string{"", "12", "fast", "-1µs", "1.2.3ms"} {
+		if _, err := ParseLegacyTime(bad); err == nil {
+			t.Errorf("ParseLegacyTime(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestReadLegacyText(t *testing.T) {
+	text := `Benchmark results
+Date: 2024-04-09T23:43:11Z
+Author: Junior ADI
+---------------------------------------
+Benchmark for array size 10
+Benchmark for Naive algorithm
+Execution time: 4.172µs
+Benchmark for HashTable algorithm
+Execution time: 1.5ms
+Statistics: min=1ms median=1.5ms mean=1.6ms p95=2ms stddev=100µs ci95=[1.5ms, 1.7ms] runs=20 rejected=2
+Allocations: 3 allocs/op, 4096 B/op
+Incorrect output: got 3 unique values, want 4
+---------------------------------------
+Benchmark for array size 100
+Benchmark for Naive algorithm
+Execution time: 3.6s
+Benchmark for Improved algorithm
+Execution time: 1h0m0s (projected)
+`
+	records, warnings, err := ReadLegacy(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("warnings %v", warnings)
+	}
+	if len(records) != 4 {
+		t.Fatalf("%d records, want 4", len(records))
+	}
+	naive, ht, large := records[0], records[1], records[2]
+	if naive.Algorithm != "Naive" || naive.Size != 10 || naive.Distribution != "sample" || naive.Ns != 4172 || naive.Runs != 1 {
+		t.Errorf("first record %+v", naive)
+	}
+	if naive.Timestamp.Year() != 2024 {
+		t.Errorf("timestamp %v, want the date of the file", naive.Timestamp)
+	}
+	if ht.Ns != 1500000 || ht.MinNs != 1000000 || ht.CIHighNs != 1700000 || ht.Runs != 20 || ht.Rejected != 2 ||
+		ht.Allocs != 3 || ht.Bytes != 4096 || ht.Failure != "got 3 unique values, want 4" {
+		t.Errorf("second record %+v", ht)
+	}
+	if large.Size != 100 || large.Distribution != "random" || large.Ns != 3600000000 {
+		t.Errorf("third record %+v", large)
+	}
+	if projected := records[3]; !projected.Projected || projected.Runs != 0 || projected.Ns != 3600e9 {
+		t.Errorf("projected record %+v", projected)
+	}
+
+	for _, bad := range []string{
+		"Benchmark for array size 10\nBenchmark for Naive algorithm\n",
+		"Benchmark for array size 10\nExecution time: 1ms\n",
+		"Benchmark for array size 10\nBenchmark for Naive algorithm\nExecution time: soon\n",
+		"Benchmark for array size 10\nsomething else\n",
+	} {
+		if _, _, err := ReadLegacy(strings.NewReader(bad)); err == nil {
+			t.Errorf("ReadLegacy(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestReadLegacyTextInput(t *testing.T) {
+	records := testRecords()
+	records[2].Seed = 7
+	var buf bytes.Buffer
+	if err := WriteText(&buf, records, records[0].Environment); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := ReadLegacy(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(records) {
+		t.Fatalf("%d records, want %d", len(got), len(records))
+	}
+	for i := range records {
+		if got[i].Distribution != records[i].Distribution || got[i].Seed != records[i].Seed {
+			t.Errorf("record %d: distribution %s, seed %d, want %s, %d",
+				i, got[i].Distribution, got[i].Seed, records[i].Distribution, records[i].Seed)
+		}
+	}
+	if !got[2].Projected {
+		t.Errorf("record 2 is not projected")
+	}
+	if got[3].Skipped != records[3].Skipped || got[3].Runs != 0 {
+		t.Errorf("skipped record %+v, want reason %q", got[3], records[3].Skipped)
+	}
+
+	bad := "Benchmark for array size 10\nInput: distribution=random seed=1\n"
+	if _, _, err := ReadLegacy(strings.NewReader(bad)); err == nil {
+		t.Errorf("ReadLegacy(%q) succeeded", bad)
+	}
+}
+
+func TestReadLegacyBest(t *testing.T) {
+	text := `Array size: 10
+Naive algorithm: 4.172µs
+HashTable algorithm: 3.541µs
+-----------------------------
+Array size: 20
+Naive algorithm: 4.172µs
+HashTable algorithm: 8.797µs
+-----------------------------
+Array size: 30
+Naive algorithm: 4.172µs
+HashTable algorithm: 6400.446µs
+-----------------------------
+`
+	records, warnings, err := ReadLegacy(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 6 || records[5].Algorithm != "HashTable" || records[5].Size != 30 || records[5].Ns != 6400446 {
+		t.Errorf("records %+v", records)
+	}
+	if len(warnings) != 2 || warnings[0] != bestUnitsWarning || !strings.Contains(warnings[1], "Naive algorithm has the same time 4.172µs at all 3 array sizes") {
+		t.Errorf("warnings %q, want the units warning and one about Naive", warnings)
+	}
+}
+
+func TestReadLegacyBestUnits(t *testing.T) {
+	text := `Array size: 10
+Naive algorithm: 861ns
+Improved algorithm: 1.098µs
+HashTable algorithm: 2.5ms
+BitHashTable algorithm: 3.6797s
+`
+	records, warnings, err := ReadLegacy(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int64{861, 1098, 2500000, 3679700000}
+	if len(records) != len(want) {
+		t.Fatalf("records %+v, want %d", records, len(want))
+	}
+	for i, ns := range want {
+		if records[i].Ns != ns {
+			t.Errorf("%s algorithm: %d ns, want %d", records[i].Algorithm, records[i].Ns, ns)
+		}
+	}
+	if len(warnings) != 1 || warnings[0] != bestUnitsWarning {
+		t.Errorf("warnings %q, want the units warning", warnings)
+	}
+}
+
+// TestReadLegacyFiles imports the legacy files of the repository.
+func TestReadLegacyFiles(t *testing.T) {
+	for _, test := range []struct {
+		path     string
+		records  int
+		warnings int
+	}{
+		{"../benchmark_results.txt", 170, 0},
+		// The units warning, and every algorithm repeats the times of array
+		// size 10.
+		{"../benchmark_best_results.txt", 170, 6},
+	} {
+		file, err := os.Open(test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, warnings, err := ReadLegacy(file)
+		file.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", test.path, err)
+		}
+		if len(records) != test.records || len(warnings) != test.warnings {
+			t.Errorf("%s: %d records and warnings %q, want %d records and %d warnings", test.path, len(records), warnings, test.records, test.warnings)
+		}
+	}
+}
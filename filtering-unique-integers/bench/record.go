@@ -0,0 +1,389 @@
+/*
+Author: Junior ADI
+Description: Structured benchmark results in JSON and CSV
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SchemaVersion is the version of the JSON and CSV result formats. It
+// changes whenever a field changes meaning or is removed, not when an
+// optional field is added. The samples_ns, failure, projected and skipped
+// fields are optional in version 1: the records written before they existed
+// read as complete, unprojected records without samples.
+const SchemaVersion = 1
+
+// Record is the measurement of one algorithm on one input. All times are
+// integer nanoseconds per call.
+type Record struct {
+	Algorithm    string `json:"algorithm"`
+	Size         int    `json:"size"`
+	Distribution string `json:"distribution"`
+	// Seed is the seed the input was generated from, 0 when the input was
+	// not generated from a known seed.
+	Seed uint64 `json:"seed"`
+
+	// Ns is the median time of a call.
+	Ns       int64 `json:"ns"`
+	MinNs    int64 `json:"min_ns"`
+	MeanNs   int64 `json:"mean_ns"`
+	P95Ns    int64 `json:"p95_ns"`
+	StdDevNs int64 `json:"stddev_ns"`
+	CILowNs  int64 `json:"ci_low_ns"`
+	CIHighNs int64 `json:"ci_high_ns"`
+	Runs     int   `json:"runs"`
+	Rejected int   `json:"rejected"`
+	// SamplesNs are the raw samples, outliers included.
+	SamplesNs []int64 `json:"samples_ns,omitempty"`
+
+	// Allocs and Bytes are the heap allocations and bytes of one call.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+
+	// Failure describes how the output of the algorithm differed from the
+	// reference filter on this input, empty if it did not.
+	Failure string `json:"failure,omitempty"`
+	// Projected tells that the algorithm exceeded the time budget at this
+	// size or a smaller one, so its times are projected from its growth
+	// curve rather than measured.
+	Projected bool `json:"projected,omitempty"`
+	// Skipped is the reason the algorithm was not run on this input, such
+	// as the memory it would need, empty if it was. A skipped record has no
+	// times.
+	Skipped string `json:"skipped,omitempty"`
+
+	Environment
+}
+
+// NewRecord returns the record of measurement for the given algorithm and
+// input, run in env.
+func NewRecord(algorithm string, size int, distribution string, seed uint64, measurement Measurement, env Environment) Record {
+	summary := measurement.Summary
+	samples := make([]int64, len(measurement.Samples))
+	for i, sample := range measurement.Samples {
+		samples[i] = int64(math.Round(sample))
+	}
+	return Record{
+		Algorithm:    algorithm,
+		Size:         size,
+		Distribution: distribution,
+		Seed:         seed,
+		Ns:           int64(math.Round(summary.Median)),
+		MinNs:        int64(math.Round(summary.Min)),
+		MeanNs:       int64(math.Round(summary.Mean)),
+		P95Ns:        int64(math.Round(summary.P95)),
+		StdDevNs:     int64(math.Round(summary.StdDev)),
+		CILowNs:      int64(math.Round(summary.CILow)),
+		CIHighNs:     int64(math.Round(summary.CIHigh)),
+		Runs:         summary.Runs,
+		Rejected:     summary.Rejected,
+		SamplesNs:    samples,
+		Allocs:       measurement.AllocsPerOp,
+		Bytes:        measurement.BytesPerOp,
+		Environment:  env,
+	}
+}
+
+// NewProjectedRecord returns the record of the time ns, in nanoseconds,
+// projected for the given algorithm and input, run in env.
+func NewProjectedRecord(algorithm string, size int, distribution string, seed uint64, ns float64, env Environment) Record {
+	rounded := int64(math.Round(ns))
+	return Record{
+		Algorithm:    algorithm,
+		Size:         size,
+		Distribution: distribution,
+		Seed:         seed,
+		Ns:           rounded,
+		MinNs:        rounded,
+		MeanNs:       rounded,
+		P95Ns:        rounded,
+		CILowNs:      rounded,
+		CIHighNs:     rounded,
+		Projected:    true,
+		Environment:  env,
+	}
+}
+
+// NewSkippedRecord returns the record of the given algorithm not run on the
+// given input for reason, in env.
+func NewSkippedRecord(algorithm string, size int, distribution string, seed uint64, reason string, env Environment) Record {
+	return Record{
+		Algorithm:    algorithm,
+		Size:         size,
+		Distribution: distribution,
+		Seed:         seed,
+		Skipped:      reason,
+		Environment:  env,
+	}
+}
+
+// results is the JSON document holding the records.
+type results struct {
+	SchemaVersion int      `json:"schema_version"`
+	Records       []Record `json:"records"`
+}
+
+// WriteJSON writes records as a versioned JSON document.
+func WriteJSON(w io.Writer, records []Record) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results{SchemaVersion: SchemaVersion, Records: records})
+}
+
+// ReadJSON reads the records of a JSON document written by WriteJSON.
+func ReadJSON(r io.Reader) ([]Record, error) {
+	var doc results
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc.SchemaVersion != SchemaVersion {
+		return nil, fmt.Errorf("unsupported schema version %d, want %d", doc.SchemaVersion, SchemaVersion)
+	}
+	return doc.Records, nil
+}
+
+// csvHeader is the header row of the CSV format. The samples are joined by
+// spaces in a single column.
+var csvHeader = []string{
+	"schema_version", "algorithm", "size", "distribution", "seed",
+	"ns", "min_ns", "mean_ns", "p95_ns", "stddev_ns", "ci_low_ns", "ci_high_ns",
+	"runs", "rejected", "samples_ns", "allocs", "bytes", "failure", "projected",
+	"skipped", "go_version", "goos", "goarch", "gomaxprocs", "cpu_model", "timestamp",
+}
+
+// WriteCSV writes records as CSV, one row per record after a header row.
+func WriteCSV(w io.Writer, records []Record) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, record := range records {
+		samples := make([]string, len(record.SamplesNs))
+		for i, sample := range record.SamplesNs {
+			samples[i] = strconv.FormatInt(sample, 10)
+		}
+		row := []string{
+			strconv.Itoa(SchemaVersion), record.Algorithm, strconv.Itoa(record.Size), record.Distribution,
+			strconv.FormatUint(record.Seed, 10),
+			strconv.FormatInt(record.Ns, 10), strconv.FormatInt(record.MinNs, 10),
+			strconv.FormatInt(record.MeanNs, 10), strconv.FormatInt(record.P95Ns, 10),
+			strconv.FormatInt(record.StdDevNs, 10), strconv.FormatInt(record.CILowNs, 10),
+			strconv.FormatInt(record.CIHighNs, 10),
+			strconv.Itoa(record.Runs), strconv.Itoa(record.Rejected), strings.Join(samples, " "),
+			strconv.FormatUint(record.Allocs, 10), strconv.FormatUint(record.Bytes, 10), record.Failure,
+			strconv.FormatBool(record.Projected), record.Skipped,
+			record.GoVersion, record.GOOS, record.GOARCH, strconv.Itoa(record.GOMAXPROCS),
+			record.CPUModel, record.Timestamp.Format(time.RFC3339),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvRow reads the fields of a CSV row by column name, remembering the
+// first parse error.
+type csvRow struct {
+	columns map[string]int
+	fields  []string
+	err     error
+}
+
+func (r *csvRow) str(name string) string {
+	index, ok := r.columns[name]
+	if !ok || index >= len(r.fields) {
+		if r.err == nil {
+			r.err = fmt.Errorf("missing column %q", name)
+		}
+		return ""
+	}
+	return r.fields[index]
+}
+
+// optStr returns the field of an optional column, empty if the column is
+// missing.
+func (r *csvRow) optStr(name string) string {
+	if index, ok := r.columns[name]; ok && index < len(r.fields) {
+		return r.fields[index]
+	}
+	return ""
+}
+
+func (r *csvRow) int64(name string) int64 {
+	value, err := strconv.ParseInt(r.str(name), 10, 64)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("column %q: %w", name, err)
+	}
+	return value
+}
+
+func (r *csvRow) uint64(name string) uint64 {
+	value, err := strconv.ParseUint(r.str(name), 10, 64)
+	if err != nil && r.err == nil {
+		r.err = fmt.Errorf("column %q: %w", name, err)
+	}
+	return value
+}
+
+// ReadCSV reads the records of a CSV document written by WriteCSV.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("missing CSV header")
+	}
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[name] = i
+	}
+
+	var records []Record
+	for line, fields := range rows[1:] {
+		row := &csvRow{columns: columns, fields: fields}
+		if version := row.int64("schema_version"); row.err == nil && version != SchemaVersion {
+			return nil, fmt.Errorf("line %d: unsupported schema version %d, want %d", line+2, version, SchemaVersion)
+		}
+		record := Record{
+			Algorithm:    row.str("algorithm"),
+			Size:         int(row.int64("size")),
+			Distribution: row.str("distribution"),
+			Seed:         row.uint64("seed"),
+			Ns:           row.int64("ns"),
+			MinNs:        row.int64("min_ns"),
+			MeanNs:       row.int64("mean_ns"),
+			P95Ns:        row.int64("p95_ns"),
+			StdDevNs:     row.int64("stddev_ns"),
+			CILowNs:      row.int64("ci_low_ns"),
+			CIHighNs:     row.int64("ci_high_ns"),
+			Runs:         int(row.int64("runs")),
+			Rejected:     int(row.int64("rejected")),
+			Allocs:       row.uint64("allocs"),
+			Bytes:        row.uint64("bytes"),
+			Failure:      row.optStr("failure"),
+			Skipped:      row.optStr("skipped"),
+			Environment: Environment{
+				GoVersion:  row.str("go_version"),
+				GOOS:       row.str("goos"),
+				GOARCH:     row.str("goarch"),
+				GOMAXPROCS: int(row.int64("gomaxprocs")),
+				CPUModel:   row.str("cpu_model"),
+			},
+		}
+		if projected := row.optStr("projected"); projected != "" {
+			value, err := strconv.ParseBool(projected)
+			if err != nil && row.err == nil {
+				row.err = fmt.Errorf("column %q: %w", "projected", err)
+			}
+			record.Projected = value
+		}
+		for _, sample := range strings.Fields(row.optStr("samples_ns")) {
+			value, err := strconv.ParseInt(sample, 10, 64)
+			if err != nil && row.err == nil {
+				row.err = fmt.Errorf("column %q: %w", "samples_ns", err)
+			}
+			record.SamplesNs = append(record.SamplesNs, value)
+		}
+		if timestamp := row.str("timestamp"); row.err == nil {
+			record.Timestamp, row.err = time.Parse(time.RFC3339, timestamp)
+		}
+		if row.err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+2, row.err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// WriteText writes records in the free text format of benchmark_results.txt,
+// one block per array size in the order the sizes first appear.
+func WriteText(out io.Writer, records []Record, env Environment) error {
+	w := bufio.NewWriter(out)
+	fmt.Fprintf(w, "Benchmark results\n")
+	fmt.Fprintf(w, "Date: %s\n", env.Timestamp.Format(time.RFC3339))
+	fmt.Fprintf(w, "Author: Junior ADI\n")
+	fmt.Fprintf(w, "---------------------------------------\n")
+
+	var sizes []int
+	bySize := make(map[int][]Record)
+	for _, record := range records {
+		if _, ok := bySize[record.Size]; !ok {
+			sizes = append(sizes, record.Size)
+		}
+		bySize[record.Size] = append(bySize[record.Size], record)
+	}
+	for _, size := range sizes {
+		fmt.Fprintf(w, "Benchmark for array size %d\n", size)
+		for _, record := range bySize[size] {
+			fmt.Fprintf(w, "Benchmark for %s algorithm\n", record.Algorithm)
+			fmt.Fprintf(w, "Input: distribution=%s seed=%d\n", record.Distribution, record.Seed)
+			if record.Projected {
+				fmt.Fprintf(w, "Execution time: %v (projected)\n", time.Duration(record.Ns))
+				continue
+			}
+			if record.Skipped != "" {
+				fmt.Fprintf(w, "Skipped: %s\n", record.Skipped)
+				continue
+			}
+			fmt.Fprintf(w, "Execution time: %v\n", time.Duration(record.Ns))
+			fmt.Fprintf(w, "Statistics: min=%v median=%v mean=%v p95=%v stddev=%v ci95=[%v, %v] runs=%d rejected=%d\n",
+				time.Duration(record.MinNs), time.Duration(record.Ns), time.Duration(record.MeanNs),
+				time.Duration(record.P95Ns), time.Duration(record.StdDevNs),
+				time.Duration(record.CILowNs), time.Duration(record.CIHighNs), record.Runs, record.Rejected)
+			fmt.Fprintf(w, "Allocations: %d allocs/op, %d B/op\n", record.Allocs, record.Bytes)
+			if record.Failure != "" {
+				fmt.Fprintf(w, "Incorrect output: %s\n", record.Failure)
+			}
+		}
+		fmt.Fprintf(w, "---------------------------------------\n")
+	}
+	return w.Flush()
+}
+
+// ReadFile reads the records of a JSON or CSV file, chosen by the extension
+// of path.
+func ReadFile(path string) ([]Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []Record
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		records, err = ReadJSON(file)
+	case ".csv":
+		records, err = ReadCSV(file)
+	default:
+		return nil, fmt.Errorf("%s: unknown result format %q, want .json or .csv", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,100 @@
+package bench
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRecords() []Record {
+	env := Environment{
+		GoVersion:  "go1.22.0",
+		GOOS:       "linux",
+		GOARCH:     "amd64",
+		GOMAXPROCS: 8,
+		CPUModel:   "Test CPU, 3.0GHz",
+		Timestamp:  time.Date(2024, 4, 9, 23, 43, 11, 0, time.UTC),
+	}
+	measurement := Measurement{
+		Samples:     []float64{1000.4, 1100, 990, 1010, 5000},
+		AllocsPerOp: 3,
+		BytesPerOp:  4096,
+	}
+	measurement.Summary = Summarize(measurement.Samples)
+	failed := NewRecord("Naive", 10, "sample", 0, Measurement{Samples: []float64{7}, Summary: Summarize([]float64{7})}, env)
+	failed.Failure = "got 3 values, want 4"
+	return []Record{
+		NewRecord("HashTable", 1000, "random", 42, measurement, env),
+		failed,
+		NewProjectedRecord("Naive", 10000000, "random", 0, 3.5e12, env),
+		NewSkippedRecord("HT", 500000, "multiples-65536", 9, "input touches 65536 blocks of values of 8192 bytes each, more than -max-block-memory 268435456", env),
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	records := testRecords()
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"schema_version": 1`) {
+		t.Errorf("JSON output has no schema version:\n%s", buf.String())
+	}
+	got, err := ReadJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("ReadJSON = %+v, want %+v", got, records)
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	records := testRecords()
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, records); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("ReadCSV = %+v, want %+v", got, records)
+	}
+}
+
+func TestReadJSONVersion(t *testing.T) {
+	if _, err := ReadJSON(strings.NewReader(`{"schema_version": 99, "records": []}`)); err == nil {
+		t.Error("ReadJSON accepted an unknown schema version")
+	}
+}
+
+// TestReadOptionalFields reads version 1 records written before the
+// samples_ns, failure, projected and skipped fields existed.
+func TestReadOptionalFields(t *testing.T) {
+	want := Record{Algorithm: "HT", Size: 1000, Distribution: "random", Seed: 42, Ns: 1500, Runs: 20, Bytes: 4096}
+	want.Timestamp = time.Date(2024, 4, 9, 23, 43, 11, 0, time.UTC)
+
+	records, err := ReadJSON(strings.NewReader(`{"schema_version": 1, "records": [{"algorithm": "HT", "size": 1000,
+		"distribution": "random", "seed": 42, "ns": 1500, "runs": 20, "bytes": 4096, "timestamp": "2024-04-09T23:43:11Z"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !reflect.DeepEqual(records[0], want) {
+		t.Errorf("ReadJSON = %+v, want %+v", records, want)
+	}
+
+	text := "schema_version,algorithm,size,distribution,seed,ns,min_ns,mean_ns,p95_ns,stddev_ns,ci_low_ns,ci_high_ns," +
+		"runs,rejected,allocs,bytes,go_version,goos,goarch,gomaxprocs,cpu_model,timestamp\n" +
+		"1,HT,1000,random,42,1500,0,0,0,0,0,0,20,0,0,4096,,,,0,,2024-04-09T23:43:11Z\n"
+	records, err = ReadCSV(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || !reflect.DeepEqual(records[0], want) {
+		t.Errorf("ReadCSV = %+v, want %+v", records, want)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Author: Junior ADI
+Description: Benchmark runner with warmup and repeated measurements
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package bench measures the unique integers filters with repeated runs and
+// describes the measurements statistically.
+package bench
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"time"
+)
+
+// Runner repeats a measurement until its result is precise enough.
+//
+// A sample is the mean time of one call over a batch of calls. The batch is
+// sized during the warmup so that a sample lasts at least MinSampleTime,
+// which keeps the clock resolution out of the measurement of fast calls.
+// After Warmup batches, samples are taken until there are at least MinRuns
+// of them and either the 95% confidence interval of the mean is narrower
+// than TargetRelErr of the mean or MaxRuns samples are taken. Once the
+// TimeBudget is spent, including calibration and warmup, the measurement
+// stops after its next sample, so a call slower than the budget is only
+// repeated for the calibration and a single sample. A TimeBudget of 0 is
+// unlimited, and so is a MaxRuns of 0: at least one of TimeBudget, MaxRuns
+// and TargetRelErr must then end the measurement.
+type Runner struct {
+	Warmup        int
+	MinRuns       int
+	MaxRuns       int
+	TargetRelErr  float64
+	TimeBudget    time.Duration
+	MinSampleTime time.Duration
+}
+
+// DefaultRunner is the Runner used by the benchmark command by default.
+var DefaultRunner = Runner{
+	Warmup:        3,
+	MinRuns:       5,
+	MaxRuns:       100,
+	TargetRelErr:  0.02,
+	TimeBudget:    2 * time.Second,
+	MinSampleTime: 100 * time.Microsecond,
+}
+
+// Measurement is the result of Runner.Measure.
+type Measurement struct {
+	// Samples are the raw samples in nanoseconds per call, outliers
+	// included, in the order they were taken.
+	Samples []float64
+	// CallsPerSample is the number of calls timed by each sample.
+	CallsPerSample int
+	// Summary describes the samples after the outlier rejection.
+	Summary Summary
+	// AllocsPerOp and BytesPerOp are the number of heap allocations and
+	// bytes allocated by one call.
+	AllocsPerOp uint64
+	BytesPerOp  uint64
+}
+
+// ErrOverBudget is returned by Runner.MeasureContext when the first call of
+// the function is still running once the time budget is spent.
+var ErrOverBudget = errors.New("bench: a single call exceeded the time budget")
+
+// measureAllocs returns the number of heap allocations and bytes allocated
+// by one call of fn, and the time it took.
+func measureAllocs(fn func() error) (uint64, uint64, time.Duration, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	elapsed, err := timeBatch(fn, 1)
+	runtime.ReadMemStats(&after)
+	return after.Mallocs - before.Mallocs, after.TotalAlloc - before.TotalAlloc, elapsed, err
+}
+
+// timeBatch returns the time taken by calls calls of fn, stopping at the
+// first error.
+func timeBatch(fn func() error, calls int) (time.Duration, error) {
+	startTime := time.Now()
+	for i := 0; i < calls; i++ {
+		if err := fn(); err != nil {
+			return time.Since(startTime), err
+		}
+	}
+	return time.Since(startTime), nil
+}
+
+// calibrate returns the number of calls of fn needed for a batch to last at
+// least minTime, given that a single call took elapsed.
+func calibrate(fn func() error, minTime, elapsed time.Duration) (int, error) {
+	calls := 1
+	for {
+		if elapsed >= minTime || calls >= 1<<30 {
+			return calls, nil
+		}
+		var err error
+		if elapsed <= 0 {
+			calls *= 100
+			if elapsed, err = timeBatch(fn, calls); err != nil {
+				return calls, err
+			}
+			continue
+		}
+		// Aim 20% above the minimum, growing at most 100x at once.
+		next := int(float64(calls) * 1.2 * float64(minTime) / float64(elapsed))
+		if next > 100*calls {
+			next = 100 * calls
+		}
+		if next <= calls {
+			next = calls + 1
+		}
+		calls = next
+		if elapsed, err = timeBatch(fn, calls); err != nil {
+			return calls, err
+		}
+	}
+}
+
+// Measure measures the time of one call of fn.
+func (r Runner) Measure(fn func()) Measurement {
+	measurement, _ := r.measure(func() error {
+		fn()
+		return nil
+	})
+	return measurement
+}
+
+// MeasureContext is Measure for a function that can be cancelled. The
+// context passed to fn is cancelled when ctx is done or the TimeBudget is
+// spent, and fn must then return an error. A batch interrupted that way is
+// discarded: the measurement holds the samples taken before it, or the time
+// of the first call alone when it was interrupted before the first sample.
+// MeasureContext returns ErrOverBudget if the first call did not complete
+// within the budget, ctx.Err() if ctx is done, and any other error of fn
+// as is.
+func (r Runner) MeasureContext(ctx context.Context, fn func(context.Context) error) (Measurement, error) {
+	budgetCtx := ctx
+	if r.TimeBudget > 0 {
+		var cancel context.CancelFunc
+		budgetCtx, cancel = context.WithTimeout(ctx, r.TimeBudget)
+		defer cancel()
+	}
+	measurement, err := r.measure(func() error {
+		return fn(budgetCtx)
+	})
+	switch {
+	case ctx.Err() != nil:
+		return measurement, ctx.Err()
+	case errors.Is(err, context.DeadlineExceeded) && len(measurement.Samples) == 0:
+		return measurement, ErrOverBudget
+	case errors.Is(err, context.DeadlineExceeded):
+		return measurement, nil
+	}
+	return measurement, err
+}
+
+// overBudget reports whether the TimeBudget of r is spent since startTime.
+func (r Runner) overBudget(startTime time.Time) bool {
+	return r.TimeBudget > 0 && time.Since(startTime) >= r.TimeBudget
+}
+
+// measure measures the time of one call of fn until fn returns an error.
+// After an error, it returns the measurement of the samples taken so far,
+// or of the first call alone, and the error.
+func (r Runner) measure(fn func() error) (Measurement, error) {
+	startTime := time.Now()
+	allocs, bytes, first, err := measureAllocs(fn)
+	if err != nil {
+		return Measurement{}, err
+	}
+	measurement := Measurement{
+		CallsPerSample: 1,
+		AllocsPerOp:    allocs,
+		BytesPerOp:     bytes,
+	}
+	// firstOnly returns the measurement of the first call alone.
+	firstOnly := func() Measurement {
+		measurement.Samples = []float64{float64(first.Nanoseconds())}
+		measurement.Summary = Summarize(measurement.Samples)
+		return measurement
+	}
+
+	calls, err := calibrate(fn, r.MinSampleTime, first)
+	if err != nil {
+		return firstOnly(), err
+	}
+	measurement.CallsPerSample = calls
+	for i := 0; i < r.Warmup && !r.overBudget(startTime); i++ {
+		if _, err := timeBatch(fn, calls); err != nil {
+			return firstOnly(), err
+		}
+	}
+
+	for {
+		elapsed, err := timeBatch(fn, calls)
+		if err != nil {
+			if len(measurement.Samples) == 0 {
+				return firstOnly(), err
+			}
+			measurement.Summary = Summarize(measurement.Samples)
+			return measurement, err
+		}
+		measurement.Samples = append(measurement.Samples, float64(elapsed.Nanoseconds())/float64(calls))
+		runs := len(measurement.Samples)
+		overBudget := r.overBudget(startTime)
+		if runs < r.MinRuns && !overBudget {
+			continue
+		}
+		measurement.Summary = Summarize(measurement.Samples)
+		if overBudget ||
+			measurement.Summary.RelErr() <= r.TargetRelErr ||
+			(r.MaxRuns > 0 && runs >= r.MaxRuns) {
+			return measurement, nil
+		}
+	}
+}
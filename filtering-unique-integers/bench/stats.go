@@ -0,0 +1,256 @@
+/*
+Author: Junior ADI
+Description: Statistics of the benchmark measurements
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package bench
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Summary describes a set of samples, in nanoseconds.
+type Summary struct {
+	// Runs is the number of samples kept after the outlier rejection.
+	Runs int
+	// Rejected is the number of samples rejected as outliers.
+	Rejected int
+
+	Min    float64
+	Median float64
+	Mean   float64
+	P95    float64
+	StdDev float64
+
+	// CILow and CIHigh bound the 95% confidence interval of the mean.
+	CILow  float64
+	CIHigh float64
+}
+
+// RelErr returns the half-width of the confidence interval relative to the
+// mean, or +Inf if it cannot be computed yet.
+func (s Summary) RelErr() float64 {
+	if s.Runs < 2 || s.Mean == 0 {
+		return math.Inf(1)
+	}
+	return (s.CIHigh - s.CILow) / 2 / s.Mean
+}
+
+// quantile returns the q-quantile of the sorted samples, interpolating
+// linearly between the closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// RejectOutliers returns the samples inside Tukey's fences, that is between
+// Q1 - 1.5 IQR and Q3 + 1.5 IQR where Q1 and Q3 are the first and third
+// quartiles and IQR = Q3 - Q1, sorted. Timing noise comes from preemption,
+// GC and page faults, which only make runs slower, so the fences are robust
+// where a mean and standard deviation based rule would be dragged by the
+// very outliers it tries to reject.
+func RejectOutliers(samples []float64) []float64 {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	if len(sorted) < 4 {
+		return sorted
+	}
+	q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+	iqr := q3 - q1
+	low, high := q1-1.5*iqr, q3+1.5*iqr
+	kept := sorted[:0]
+	for _, sample := range sorted {
+		if low <= sample && sample <= high {
+			kept = append(kept, sample)
+		}
+	}
+	return kept
+}
+
+// tQuantile975 returns the 0.975 quantile of Student's t-distribution with
+// df degrees of freedom, used for the two-sided 95% confidence interval.
+func tQuantile975(df int) float64 {
+	table := [...]float64{
+		12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+		2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+		2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+	}
+	if df < 1 {
+		return math.Inf(1)
+	}
+	if df <= len(table) {
+		return table[df-1]
+	}
+	// Cornish-Fisher expansion around the normal quantile, accurate to
+	// about 1e-4 above 30 degrees of freedom.
+	const z = 1.959964
+	n := float64(df)
+	return z + (z*z*z+z)/(4*n) + (5*math.Pow(z, 5)+16*z*z*z+3*z)/(96*n*n)
+}
+
+// Summarize rejects the outliers of samples with RejectOutliers and
+// describes the remaining ones.
+func Summarize(samples []float64) Summary {
+	kept := RejectOutliers(samples)
+	summary := Summary{
+		Runs:     len(kept),
+		Rejected: len(samples) - len(kept),
+	}
+	if len(kept) == 0 {
+		return summary
+	}
+
+	var sum float64
+	for _, sample := range kept {
+		sum += sample
+	}
+	summary.Min = kept[0]
+	summary.Median = quantile(kept, 0.5)
+	summary.P95 = quantile(kept, 0.95)
+	summary.Mean = sum / float64(len(kept))
+	summary.CILow, summary.CIHigh = summary.Mean, summary.Mean
+	if len(kept) < 2 {
+		return summary
+	}
+
+	var squares float64
+	for _, sample := range kept {
+		squares += (sample - summary.Mean) * (sample - summary.Mean)
+	}
+	summary.StdDev = math.Sqrt(squares / float64(len(kept)-1))
+	halfWidth := tQuantile975(len(kept)-1) * summary.StdDev / math.Sqrt(float64(len(kept)))
+	summary.CILow = summary.Mean - halfWidth
+	summary.CIHigh = summary.Mean + halfWidth
+	return summary
+}
+
+// mannWhitneyExactMax is the largest sample size for which MannWhitneyU
+// computes the exact distribution of U rather than its normal
+// approximation.
+const mannWhitneyExactMax = 20
+
+// MannWhitneyU performs the two-sided Mann-Whitney U test of whether the
+// samples x and y come from the same distribution. It returns the U
+// statistic of x, the number of pairs where the x sample is larger with
+// ties counting half, and the p-value. The p-value is exact for samples of
+// at most 20 values without ties, and otherwise comes from the normal
+// approximation with tie and continuity corrections. It is NaN when one of
+// the samples is empty.
+func MannWhitneyU(x, y []float64) (u, p float64) {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	// Rank the pooled samples, giving tied values their average rank.
+	type value struct {
+		v     float64
+		fromX bool
+	}
+	pooled := make([]value, 0, n1+n2)
+	for _, v := range x {
+		pooled = append(pooled, value{v, true})
+	}
+	for _, v := range y {
+		pooled = append(pooled, value{v, false})
+	}
+	sort.Slice(pooled, func(i, j int) bool { return pooled[i].v < pooled[j].v })
+	var rankSumX, tieTerm float64
+	for i := 0; i < len(pooled); {
+		j := i
+		for j < len(pooled) && pooled[j].v == pooled[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if pooled[k].fromX {
+				rankSumX += rank
+			}
+		}
+		t := float64(j - i)
+		tieTerm += t*t*t - t
+		i = j
+	}
+	u = rankSumX - float64(n1*(n1+1))/2
+
+	if tieTerm == 0 && n1 <= mannWhitneyExactMax && n2 <= mannWhitneyExactMax {
+		return u, mannWhitneyExactP(u, n1, n2)
+	}
+	n := float64(n1 + n2)
+	mean := float64(n1*n2) / 2
+	variance := float64(n1*n2) / 12 * (n + 1 - tieTerm/(n*(n-1)))
+	if variance <= 0 {
+		return u, 1
+	}
+	z := math.Max(math.Abs(u-mean)-0.5, 0) / math.Sqrt(variance)
+	return u, math.Erfc(z / math.Sqrt2)
+}
+
+// mannWhitneyExactP returns the two-sided p-value of the statistic u for
+// samples of n1 and n2 values without ties, from the number of orderings
+// of the pooled samples giving each value of U.
+func mannWhitneyExactP(u float64, n1, n2 int) float64 {
+	// counts[i][j][k] is the number of orderings of i values of x and j
+	// values of y where U = k. The largest value is either from x, adding
+	// j to U, or from y.
+	counts := make([][][]float64, n1+1)
+	for i := range counts {
+		counts[i] = make([][]float64, n2+1)
+		for j := range counts[i] {
+			counts[i][j] = make([]float64, i*j+1)
+			if i == 0 || j == 0 {
+				counts[i][j][0] = 1
+				continue
+			}
+			for k := range counts[i][j] {
+				if k >= j && k-j < len(counts[i-1][j]) {
+					counts[i][j][k] += counts[i-1][j][k-j]
+				}
+				if k < len(counts[i][j-1]) {
+					counts[i][j][k] += counts[i][j-1][k]
+				}
+			}
+		}
+	}
+
+	var total, below, above float64
+	for k, count := range counts[n1][n2] {
+		total += count
+		if float64(k) <= u {
+			below += count
+		}
+		if float64(k) >= u {
+			above += count
+		}
+	}
+	return math.Min(1, 2*math.Min(below, above)/total)
+}
+
+// FormatNs formats a number of nanoseconds as a duration with at most 3
+// significant digits.
+func FormatNs(v float64) string {
+	for _, unit := range []struct {
+		scale float64
+		name  string
+	}{{1e9, "s"}, {1e6, "ms"}, {1e3, "µs"}} {
+		if math.Abs(v) >= unit.scale {
+			return fmt.Sprintf("%.3g%s", v/unit.scale, unit.name)
+		}
+	}
+	return fmt.Sprintf("%.3gns", v)
+}
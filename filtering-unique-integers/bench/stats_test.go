@@ -0,0 +1,236 @@
+package bench
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	samples := []float64{10, 12, 11, 13, 9, 11, 10, 12, 11, 1000}
+	summary := Summarize(samples)
+	if summary.Runs != 9 || summary.Rejected != 1 {
+		t.Fatalf("Runs, Rejected = %d, %d, want 9, 1", summary.Runs, summary.Rejected)
+	}
+	if summary.Min != 9 || summary.Median != 11 || summary.Mean != 11 {
+		t.Errorf("Min, Median, Mean = %v, %v, %v, want 9, 11, 11", summary.Min, summary.Median, summary.Mean)
+	}
+	if want := math.Sqrt(12.0 / 8); math.Abs(summary.StdDev-want) > 1e-9 {
+		t.Errorf("StdDev = %v, want %v", summary.StdDev, want)
+	}
+	halfWidth := 2.306 * summary.StdDev / 3
+	if math.Abs(summary.CILow-(11-halfWidth)) > 1e-9 || math.Abs(summary.CIHigh-(11+halfWidth)) > 1e-9 {
+		t.Errorf("CI = [%v, %v], want 11 ± %v", summary.CILow, summary.CIHigh, halfWidth)
+	}
+	if summary.P95 < 12 || summary.P95 > 13 {
+		t.Errorf("P95 = %v, want between 12 and 13", summary.P95)
+	}
+}
+
+func TestSummarizeSmall(t *testing.T) {
+	if summary := Summarize(nil); summary.Runs != 0 || !math.IsInf(summary.RelErr(), 1) {
+		t.Errorf("Summarize(nil) = %+v", summary)
+	}
+	summary := Summarize([]float64{42})
+	if summary.Runs != 1 || summary.Mean != 42 || summary.CILow != 42 || summary.CIHigh != 42 {
+		t.Errorf("Summarize([42]) = %+v", summary)
+	}
+}
+
+func TestTQuantile975(t *testing.T) {
+	// Values from the usual t table.
+	for df, want := range map[int]float64{1: 12.706, 10: 2.228, 40: 2.021, 60: 2.000, 120: 1.980} {
+		if got := tQuantile975(df); math.Abs(got-want) > 1e-3 {
+			t.Errorf("tQuantile975(%d) = %v, want %v", df, got, want)
+		}
+	}
+}
+
+func TestRunnerMeasure(t *testing.T) {
+	runner := Runner{Warmup: 1, MinRuns: 5, MaxRuns: 20, TargetRelErr: 0.5, TimeBudget: time.Minute, MinSampleTime: 10000}
+	sum := 0
+	measurement := runner.Measure(func() {
+		for i := 0; i < 100; i++ {
+			sum += i
+		}
+	})
+	if n := len(measurement.Samples); n < runner.MinRuns || n > runner.MaxRuns {
+		t.Errorf("got %d samples, want between %d and %d", n, runner.MinRuns, runner.MaxRuns)
+	}
+	if measurement.CallsPerSample < 1 || measurement.Summary.Mean <= 0 {
+		t.Errorf("Measure = %+v", measurement)
+	}
+}
+
+func TestRunnerMeasureOverBudget(t *testing.T) {
+	runner := Runner{Warmup: 3, MinRuns: 5, MaxRuns: 20, TargetRelErr: 0.01, TimeBudget: time.Millisecond, MinSampleTime: time.Microsecond}
+	calls := 0
+	measurement := runner.Measure(func() {
+		calls++
+		time.Sleep(2 * time.Millisecond)
+	})
+	// One call calibrates, one call takes the single sample.
+	if calls != 2 || len(measurement.Samples) != 1 {
+		t.Errorf("got %d calls and %d samples, want 2 and 1", calls, len(measurement.Samples))
+	}
+}
+
+func TestRunnerMeasureNoBudget(t *testing.T) {
+	runner := Runner{Warmup: 2, MinRuns: 5, MaxRuns: 5, TargetRelErr: 0.5, MinSampleTime: time.Microsecond}
+	calls := 0
+	measurement := runner.Measure(func() {
+		calls++
+		time.Sleep(100 * time.Microsecond)
+	})
+	// A budget of 0 is unlimited: one call calibrates, two warm up and five
+	// take the samples.
+	if calls != 8 || len(measurement.Samples) != 5 {
+		t.Errorf("got %d calls and %d samples, want 8 and 5", calls, len(measurement.Samples))
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	seq := func(from, to float64) []float64 {
+		var s []float64
+		for v := from; v <= to; v++ {
+			s = append(s, v)
+		}
+		return s
+	}
+	for _, test := range []struct {
+		name       string
+		x, y       []float64
+		u          float64
+		pLow, pMax float64
+	}{
+		// Exact: 2 of the 252 orderings of 5+5 values are as extreme.
+		{"exact separated", seq(1, 5), seq(6, 10), 0, 2.0 / 252 * 0.999, 2.0 / 252 * 1.001},
+		{"exact reversed", seq(6, 10), seq(1, 5), 25, 2.0 / 252 * 0.999, 2.0 / 252 * 1.001},
+		{"exact interleaved", []float64{1, 4, 5, 8}, []float64{2, 3, 6, 7}, 8, 1, 1},
+		{"ties", []float64{1, 2, 3}, []float64{1, 2, 3}, 4.5, 1, 1},
+		{"normal separated", seq(1, 30), seq(31, 60), 0, 0, 1e-9},
+	} {
+		u, p := MannWhitneyU(test.x, test.y)
+		if u != test.u || p < test.pLow || p > test.pMax {
+			t.Errorf("%s: MannWhitneyU = %g, %g, want %g and p in [%g, %g]", test.name, u, p, test.u, test.pLow, test.pMax)
+		}
+	}
+	if _, p := MannWhitneyU(nil, []float64{1}); !math.IsNaN(p) {
+		t.Errorf("MannWhitneyU of an empty sample: p = %g, want NaN", p)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	before := []Record{
+		{Algorithm: "A", Size: 100, Distribution: "random", Ns: 1000, SamplesNs: []int64{1000, 1010, 990, 1005, 995, 1002}},
+		{Algorithm: "B", Size: 100, Distribution: "random", Ns: 500},
+		{Algorithm: "C", Size: 100, Distribution: "random", Ns: 500},
+	}
+	after := []Record{
+		{Algorithm: "A", Size: 100, Distribution: "random", Ns: 1500, SamplesNs: []int64{1500, 1510, 1490}},
+		{Algorithm: "A", Size: 100, Distribution: "random", Ns: 1505, SamplesNs: []int64{1505, 1495, 1502}},
+		{Algorithm: "B", Size: 100, Distribution: "random", Ns: 250},
+	}
+	comparisons, unmatched := Compare(before, after)
+	if len(comparisons) != 2 || len(unmatched) != 1 || unmatched[0] != "C/size=100/dist=random (old only)" {
+		t.Fatalf("Compare = %+v, %v", comparisons, unmatched)
+	}
+	a, b := comparisons[0], comparisons[1]
+	if a.Algorithm != "A" || a.NewRuns != 6 || a.Delta < 0.49 || a.Delta > 0.51 || !a.Regression(0.05, 0.05) {
+		t.Errorf("comparison of A = %+v, want a significant 50%% regression", a)
+	}
+	if a.Regression(0.6, 0.05) {
+		t.Errorf("comparison of A is a regression above a 60%% threshold")
+	}
+	if b.Delta != -0.5 || !math.IsNaN(b.P) || b.Regression(0, 1) {
+		t.Errorf("comparison of B = %+v, want a 50%% speedup without p-value", b)
+	}
+}
+
+func TestFitGrowth(t *testing.T) {
+	// 3n² + noise at the small sizes, which are left out of the fit.
+	sizes := []int{10, 20, 100, 1000, 2000, 5000, 10000}
+	ns := []float64{5000, 9000, 30000, 3e6, 12e6, 75e6, 3e8}
+	g, err := FitGrowth(sizes, ns, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(g.Exp-2) > 1e-9 || math.Abs(g.At(100000)/3e10-1) > 1e-9 {
+		t.Errorf("FitGrowth = %+v, want 3n²", g)
+	}
+	if g, err := FitGrowth([]int{1000}, []float64{2000}, 1); err != nil || g.At(1000000) != 2e6 {
+		t.Errorf("FitGrowth of one size = %+v, %v, want 2n", g, err)
+	}
+	if _, err := FitGrowth([]int{1000}, []float64{0}, 1); err == nil {
+		t.Error("FitGrowth without positive time succeeded")
+	}
+}
+
+func TestRunnerMeasureContextOverBudget(t *testing.T) {
+	r := Runner{Warmup: 1, MinRuns: 5, MaxRuns: 10, TimeBudget: 10 * time.Millisecond, MinSampleTime: time.Microsecond}
+	blocked := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if _, err := r.MeasureContext(context.Background(), blocked); err != ErrOverBudget {
+		t.Errorf("MeasureContext of a call over budget: %v, want ErrOverBudget", err)
+	}
+
+	// The first call fits in the budget, the next one does not.
+	calls := 0
+	slowing := func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return nil
+		}
+		return blocked(ctx)
+	}
+	m, err := r.MeasureContext(context.Background(), slowing)
+	if err != nil || len(m.Samples) != 1 || m.Summary.Runs != 1 {
+		t.Errorf("MeasureContext = %+v, %v, want the first call as the only sample", m, err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := r.MeasureContext(cancelled, blocked); err != context.Canceled {
+		t.Errorf("MeasureContext with a cancelled context: %v, want context.Canceled", err)
+	}
+}
+
+func TestRunnerMeasureContextError(t *testing.T) {
+	r := Runner{Warmup: 1, MinRuns: 5, MaxRuns: 10, TimeBudget: time.Second, MinSampleTime: time.Microsecond}
+	errFailed := errors.New("failed")
+	// The errors of fn other than the budget are returned as is, before
+	// and after the first sample.
+	for _, failAt := range []int{1, 3} {
+		calls := 0
+		failing := func(ctx context.Context) error {
+			calls++
+			if calls >= failAt {
+				return errFailed
+			}
+			return nil
+		}
+		if _, err := r.MeasureContext(context.Background(), failing); err != errFailed {
+			t.Errorf("MeasureContext failing at call %d: %v, want %v", failAt, err, errFailed)
+		}
+	}
+}
+
+func TestFormatNs(t *testing.T) {
+	for _, test := range []struct {
+		ns   float64
+		want string
+	}{
+		{0.25, "0.25ns"},
+		{1500, "1.5µs"},
+		{2.5e6, "2.5ms"},
+		{3.6797e9, "3.68s"},
+	} {
+		if got := FormatNs(test.ns); got != test.want {
+			t.Errorf("FormatNs(%v) = %q, want %q", test.ns, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+//go:build cgo
+
+/*
+Author: Junior ADI
+Description: The C filters of unique-integers-filter.c compiled into the cfilters package
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+#include "../unique-integers-filter.c"
@@ -0,0 +1,188 @@
+//go:build cgo
+
+/*
+Author: Junior ADI
+Description: cgo bridge to the C filters of unique-integers-filter.c
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package cfilters calls the C filters of unique-integers-filter.c on Go
+// slices, so that the C and Go implementations can be benchmarked side by
+// side and cross-checked in one process. It needs cgo.
+//
+// Importing the package registers each C filter in the uniqueints registry
+// under the name of its Go port prefixed by Prefix, such as "C/Naive" for
+// filter_unique_elems_naive, with the metadata of the port. The registered
+// filters panic on values outside the int32 range of the C int, like the Go
+// ports; the FilterUniqueElements* functions return a *RangeError instead.
+//
+// The input is copied into a C int array and the output back into a Go
+// slice, so the times include a conversion linear in the length of the
+// slices.
+package cfilters
+
+/*
+#include <stdlib.h>
+#include "../unique-integers-filter.h"
+
+typedef int *(*filter_func)(const int *, const unsigned int, unsigned int *, int *);
+
+static int *call_filter(filter_func f, const int *input_arr, unsigned int num_elems, unsigned int *num_elems_out, int *err_flag) {
+    return f(input_arr, num_elems, num_elems_out, err_flag);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+// Prefix starts the registered names of the C filters.
+const Prefix = "C/"
+
+// Unimplemented are the filters declared in unique-integers-filter.h
+// without a definition in unique-integers-filter.c, which cannot be called.
+var Unimplemented = []string{
+	"fui_bitmap_full_dyn",
+	"fui_bitmap_dbase",
+	"fui_bitmap_dbase_dyn",
+	"fui_bitmap_array",
+	"fui_bitmap_array_dyn",
+}
+
+// cFilter is a filter of unique-integers-filter.c.
+type cFilter struct {
+	// name is the name of the C function.
+	name string
+	fn   C.filter_func
+	// port is the registered name of its Go port.
+	port string
+}
+
+var cFilters = []cFilter{
+	{"filter_unique_elems_naive", C.filter_func(C.filter_unique_elems_naive), "Naive"},
+	{"filter_unique_elems_naive_improved", C.filter_func(C.filter_unique_elems_naive_improved), "Improved"},
+	{"filter_unique_elems_ht", C.filter_func(C.filter_unique_elems_ht), "HT"},
+	{"filter_unique_elems_ht_new", C.filter_func(C.filter_unique_elems_ht_new), "HTNew"},
+	{"filter_unique_elems_ht_dyn", C.filter_func(C.filter_unique_elems_ht_dyn), "HTDyn"},
+	{"fui_bitmap_stc", C.filter_func(C.fui_bitmap_stc), "BitmapStatic"},
+	{"fui_bitmap_base_dyn", C.filter_func(C.fui_bitmap_base_dyn), "BitmapBaseDynamic"},
+}
+
+func init() {
+	for _, f := range cFilters {
+		port, ok := uniqueints.Lookup(f.port)
+		if !ok {
+			panic("cfilters: no Go port " + f.port + " of " + f.name)
+		}
+		info := port.Info()
+		info.Name = Prefix + f.port
+		info.Range = uniqueints.Int32Range
+		uniqueints.Register(uniqueints.NewFilter(info, func(input []int) []int {
+			output, err := f.call(input)
+			if err != nil {
+				panic(err)
+			}
+			return output
+		}))
+	}
+}
+
+// RangeError is returned for an input value outside the int32 range of the
+// C int.
+type RangeError struct {
+	Index int
+	Value int
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("value %d at index %d is outside the int32 range of the C filters", e.Value, e.Index)
+}
+
+// Error is returned when a C filter fails, with the err_flag it set: -1 or
+// a positive value when an allocation failed.
+type Error struct {
+	Func string
+	Flag int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s failed with err_flag %d", e.Func, e.Flag)
+}
+
+// call calls the C filter on input. The C filters reject empty arrays, so
+// an empty input gives an empty output without calling it.
+func (f cFilter) call(input []int) ([]int, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	if uint64(len(input)) > math.MaxUint32 {
+		return nil, fmt.Errorf("%d values, more than the unsigned int length of the C filters", len(input))
+	}
+	in := make([]C.int, len(input))
+	for i, value := range input {
+		if value < math.MinInt32 || value > math.MaxInt32 {
+			return nil, &RangeError{Index: i, Value: value}
+		}
+		in[i] = C.int(value)
+	}
+	var numElemsOut C.uint
+	var errFlag C.int
+	out := C.call_filter(f.fn, &in[0], C.uint(len(in)), &numElemsOut, &errFlag)
+	if out == nil {
+		return nil, &Error{Func: f.name, Flag: int(errFlag)}
+	}
+	defer C.free(unsafe.Pointer(out))
+	output := make([]int, numElemsOut)
+	for i, value := range unsafe.Slice(out, numElemsOut) {
+		output[i] = int(value)
+	}
+	return output, nil
+}
+
+// FilterUniqueElementsNaive calls filter_unique_elems_naive on input.
+func FilterUniqueElementsNaive(input []int) ([]int, error) {
+	return cFilters[0].call(input)
+}
+
+// FilterUniqueElementsNaiveImproved calls filter_unique_elems_naive_improved
+// on input.
+func FilterUniqueElementsNaiveImproved(input []int) ([]int, error) {
+	return cFilters[1].call(input)
+}
+
+// FilterUniqueElementsHT calls filter_unique_elems_ht on input.
+func FilterUniqueElementsHT(input []int) ([]int, error) {
+	return cFilters[2].call(input)
+}
+
+// FilterUniqueElementsHTNew calls filter_unique_elems_ht_new on input.
+func FilterUniqueElementsHTNew(input []int) ([]int, error) {
+	return cFilters[3].call(input)
+}
+
+// FilterUniqueElementsHTDyn calls filter_unique_elems_ht_dyn on input.
+func FilterUniqueElementsHTDyn(input []int) ([]int, error) {
+	return cFilters[4].call(input)
+}
+
+// FilterUniqueElementsBitmapStatic calls fui_bitmap_stc on input.
+func FilterUniqueElementsBitmapStatic(input []int) ([]int, error) {
+	return cFilters[5].call(input)
+}
+
+// FilterUniqueElementsBitmapBaseDynamic calls fui_bitmap_base_dyn on input.
+func FilterUniqueElementsBitmapBaseDynamic(input []int) ([]int, error) {
+	return cFilters[6].call(input)
+}
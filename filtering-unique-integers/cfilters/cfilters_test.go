@@ -0,0 +1,74 @@
+//go:build cgo
+
+package cfilters
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+// testInputs returns the inputs the C filters are checked on.
+func testInputs() map[string][]int {
+	inputs := map[string][]int{
+		"sample":  {16, 17, 2, 17, 4, 2, 97, 4, 17, 56},
+		"single":  {7},
+		"zeros":   {0, 0, 0},
+		"signs":   {5, 65541, -5, -65541, 5, 0, -65536, 65536},
+		"extrema": {math.MaxInt32, math.MinInt32, math.MaxInt32, -1, math.MinInt32 + 1},
+	}
+	for _, name := range []string{"uniform", "zipf", "normal", "sorted", "reverse-sorted", "all-equal", "clustered", "sawtooth", "dup-0.9-geometric"} {
+		dist, _ := generator.Lookup(name)
+		inputs[name] = dist.Generate(generator.NewRand(1), 2000)
+	}
+	return inputs
+}
+
+func TestCFiltersMatchGoPorts(t *testing.T) {
+	for _, f := range cFilters {
+		port, _ := uniqueints.Lookup(f.port)
+		for name, input := range testInputs() {
+			got, err := f.call(input)
+			if err != nil {
+				t.Errorf("%s on %s: %v", f.name, name, err)
+				continue
+			}
+			want := port.Filter(input)
+			if !port.Info().OrderPreserving {
+				got, want = slices.Clone(got), slices.Clone(want)
+				slices.Sort(got)
+				slices.Sort(want)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("%s on %s: %d values, Go %s %d values", f.name, name, len(got), f.port, len(want))
+			}
+		}
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	for _, f := range cFilters {
+		filter, ok := uniqueints.Lookup(Prefix + f.port)
+		if !ok {
+			t.Fatalf("%s%s is not registered", Prefix, f.port)
+		}
+		if got := filter.Filter([]int{3, 1, 3}); !slices.Equal(got, []int{3, 1}) {
+			t.Errorf("%s = %v, want [3 1]", filter.Info().Name, got)
+		}
+		if got := filter.Filter(nil); len(got) != 0 {
+			t.Errorf("%s of nil = %v", filter.Info().Name, got)
+		}
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	_, err := FilterUniqueElementsHT([]int{1, math.MaxInt32 + 1})
+	var rangeErr *RangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Index != 1 {
+		t.Errorf("error %v, want a *RangeError at index 1", err)
+	}
+}
@@ -0,0 +1,313 @@
+/*
+Author: Junior ADI
+Description: SVG line charts of the benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package chart draws the benchmark results as SVG line charts, one series
+// per algorithm, without any dependency outside the standard library.
+package chart
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+)
+
+// Point is a point of a series. Low and High bound its error bar; the bar
+// is not drawn when they are equal to Y.
+type Point struct {
+	X, Y      float64
+	Low, High float64
+}
+
+// Series is a named line of a chart.
+type Series struct {
+	Name   string
+	Points []Point
+}
+
+// Chart is a line chart with a legend on the right.
+type Chart struct {
+	Title  string
+	XLabel string
+	YLabel string
+	// LogX and LogY select logarithmic axes. Points that are not positive
+	// are left out of a logarithmic axis.
+	LogX, LogY bool
+	// XFormat and YFormat format the tick labels, FormatNumber if nil.
+	XFormat, YFormat func(float64) string
+	Series           []Series
+	// Width and Height are the size of the image in pixels, 800x500 if 0.
+	Width, Height int
+}
+
+// The margins of the plot area, in pixels. The right margin holds the
+// legend.
+const (
+	marginLeft   = 80
+	marginRight  = 180
+	marginTop    = 40
+	marginBottom = 60
+)
+
+// palette is the color of each series, in order.
+var palette = []string{
+	"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f",
+	"#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac",
+}
+
+// dashes is the dash pattern of the lines of each cycle through the
+// palette, so that the series sharing a color can still be told apart.
+var dashes = []string{"", "8 4", "2 3", "8 3 2 3"}
+
+// seriesStyle returns the color and the stroke-dasharray attribute of the
+// lines of the series of index i.
+func seriesStyle(i int) (color, dash string) {
+	color = palette[i%len(palette)]
+	if d := dashes[i/len(palette)%len(dashes)]; d != "" {
+		dash = fmt.Sprintf(` stroke-dasharray="%s"`, d)
+	}
+	return color, dash
+}
+
+// axis maps the values of one dimension to pixels.
+type axis struct {
+	min, max float64
+	log      bool
+	// from and to are the pixels of min and max.
+	from, to float64
+}
+
+// pixel returns the pixel of v.
+func (a axis) pixel(v float64) float64 {
+	lo, hi := a.min, a.max
+	if a.log {
+		v, lo, hi = math.Log10(v), math.Log10(lo), math.Log10(hi)
+	}
+	if hi == lo {
+		return (a.from + a.to) / 2
+	}
+	return a.from + (v-lo)/(hi-lo)*(a.to-a.from)
+}
+
+// valid reports whether v can be drawn on the axis.
+func (a axis) valid(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0) && (!a.log || v > 0)
+}
+
+// ticks returns the tick values of the axis.
+func (a axis) ticks() []float64 {
+	var ticks []float64
+	if a.log {
+		decades := math.Log10(a.max) - math.Log10(a.min)
+		for p := math.Floor(math.Log10(a.min)); p <= math.Ceil(math.Log10(a.max)); p++ {
+			for _, m := range []float64{1, 2, 5} {
+				if m != 1 && decades > 2 {
+					continue
+				}
+				if v := m * math.Pow(10, p); v >= a.min*(1-1e-9) && v <= a.max*(1+1e-9) {
+					ticks = append(ticks, v)
+				}
+			}
+		}
+		return ticks
+	}
+	step := niceStep((a.max - a.min) / 5)
+	for v := math.Ceil(a.min/step) * step; v <= a.max+step*1e-9; v += step {
+		ticks = append(ticks, v)
+	}
+	return ticks
+}
+
+// niceStep returns the smallest step of the form 1, 2 or 5 times a power of
+// ten that is not below step.
+func niceStep(step float64) float64 {
+	if step <= 0 {
+		return 1
+	}
+	p := math.Pow(10, math.Floor(math.Log10(step)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if m*p >= step*(1-1e-9) {
+			return m * p
+		}
+	}
+	return 10 * p
+}
+
+// newAxis returns the axis covering values, rounded out to whole ticks.
+// Linear axes start at 0 when every value is positive.
+func newAxis(values []float64, log bool, from, to float64) axis {
+	a := axis{min: math.Inf(1), max: math.Inf(-1), log: log, from: from, to: to}
+	for _, v := range values {
+		a.min = math.Min(a.min, v)
+		a.max = math.Max(a.max, v)
+	}
+	if len(values) == 0 {
+		a.min, a.max = 1, 10
+	}
+	if log {
+		a.min = math.Pow(10, math.Floor(math.Log10(a.min)))
+		a.max = math.Pow(10, math.Ceil(math.Log10(a.max)))
+		if a.max == a.min {
+			a.max *= 10
+		}
+		return a
+	}
+	if a.min > 0 {
+		a.min = 0
+	}
+	if a.max == a.min {
+		a.max = a.min + 1
+	}
+	step := niceStep((a.max - a.min) / 5)
+	a.min = math.Floor(a.min/step) * step
+	a.max = math.Ceil(a.max/step) * step
+	return a
+}
+
+// FormatNumber formats v with at most 3 significant digits and a k, M or G
+// suffix.
+func FormatNumber(v float64) string {
+	return formatScaled(v, 1000, []string{"", "k", "M", "G", "T"})
+}
+
+// FormatBytes formats a number of bytes with a binary prefix.
+func FormatBytes(v float64) string {
+	return formatScaled(v, 1024, []string{"B", "KiB", "MiB", "GiB", "TiB"})
+}
+
+// formatScaled formats v divided by the largest power of base below it,
+// followed by the matching unit.
+func formatScaled(v, base float64, units []string) string {
+	i := 0
+	for math.Abs(v) >= base && i < len(units)-1 {
+		v /= base
+		i++
+	}
+	return fmt.Sprintf("%.3g%s", v, units[i])
+}
+
+// WriteSVG writes the chart as a standalone SVG image.
+func (c *Chart) WriteSVG(out io.Writer) error {
+	width, height := c.Width, c.Height
+	if width == 0 {
+		width = 800
+	}
+	if height == 0 {
+		height = 500
+	}
+	xFormat, yFormat := c.XFormat, c.YFormat
+	if xFormat == nil {
+		xFormat = FormatNumber
+	}
+	if yFormat == nil {
+		yFormat = FormatNumber
+	}
+
+	plotRight := float64(width - marginRight)
+	plotBottom := float64(height - marginBottom)
+	var xs, ys []float64
+	for _, series := range c.Series {
+		for _, p := range series.Points {
+			xs = append(xs, p.X)
+			ys = append(ys, p.Y, p.Low, p.High)
+		}
+	}
+	x := newAxis(validValues(xs, c.LogX), c.LogX, marginLeft, plotRight)
+	y := newAxis(validValues(ys, c.LogY), c.LogY, plotBottom, marginTop)
+
+	w := bufio.NewWriter(out)
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(w, `<rect width="%d" height="%d" fill="white"/>`+"\n", width, height)
+	fmt.Fprintf(w, `<text x="%d" y="%d" text-anchor="middle" font-size="16">%s</text>`+"\n",
+		(marginLeft+int(plotRight))/2, marginTop/2+6, html.EscapeString(c.Title))
+
+	for _, v := range x.ticks() {
+		px := x.pixel(v)
+		fmt.Fprintf(w, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%.1f" stroke="#e0e0e0"/>`+"\n", px, marginTop, px, plotBottom)
+		fmt.Fprintf(w, `<text x="%.1f" y="%.1f" text-anchor="middle">%s</text>`+"\n", px, plotBottom+18, html.EscapeString(xFormat(v)))
+	}
+	for _, v := range y.ticks() {
+		py := y.pixel(v)
+		fmt.Fprintf(w, `<line x1="%d" y1="%.1f" x2="%.1f" y2="%.1f" stroke="#e0e0e0"/>`+"\n", marginLeft, py, plotRight, py)
+		fmt.Fprintf(w, `<text x="%d" y="%.1f" text-anchor="end">%s</text>`+"\n", marginLeft-6, py+4, html.EscapeString(yFormat(v)))
+	}
+	fmt.Fprintf(w, `<rect x="%d" y="%d" width="%.1f" height="%.1f" fill="none" stroke="black"/>`+"\n",
+		marginLeft, marginTop, plotRight-marginLeft, plotBottom-marginTop)
+	fmt.Fprintf(w, `<text x="%.1f" y="%d" text-anchor="middle">%s</text>`+"\n",
+		(marginLeft+plotRight)/2, height-15, html.EscapeString(c.XLabel))
+	fmt.Fprintf(w, `<text x="15" y="%.1f" text-anchor="middle" transform="rotate(-90 15 %.1f)">%s</text>`+"\n",
+		(marginTop+plotBottom)/2, (marginTop+plotBottom)/2, html.EscapeString(c.YLabel))
+
+	for i, series := range c.Series {
+		color, dash := seriesStyle(i)
+		points := make([]Point, 0, len(series.Points))
+		for _, p := range series.Points {
+			if x.valid(p.X) && y.valid(p.Y) {
+				points = append(points, p)
+			}
+		}
+		sort.Slice(points, func(i, j int) bool { return points[i].X < points[j].X })
+
+		fmt.Fprintf(w, `<g stroke="%s" fill="%s">`+"\n", color, color)
+		fmt.Fprintf(w, `<title>%s</title>`+"\n", html.EscapeString(series.Name))
+		if len(points) > 1 {
+			fmt.Fprintf(w, `<polyline fill="none" stroke-width="2"%s points="`, dash)
+			for j, p := range points {
+				if j > 0 {
+					fmt.Fprint(w, " ")
+				}
+				fmt.Fprintf(w, "%.1f,%.1f", x.pixel(p.X), y.pixel(p.Y))
+			}
+			fmt.Fprintf(w, `"/>`+"\n")
+		}
+		for _, p := range points {
+			px, py := x.pixel(p.X), y.pixel(p.Y)
+			if p.Low != p.Y || p.High != p.Y {
+				low, high := py, py
+				if y.valid(p.Low) {
+					low = y.pixel(p.Low)
+				}
+				if y.valid(p.High) {
+					high = y.pixel(p.High)
+				}
+				fmt.Fprintf(w, `<path d="M%.1f %.1fV%.1fM%.1f %.1fh8M%.1f %.1fh8"/>`+"\n",
+					px, low, high, px-4, low, px-4, high)
+			}
+			fmt.Fprintf(w, `<circle cx="%.1f" cy="%.1f" r="3"/>`+"\n", px, py)
+		}
+		fmt.Fprintf(w, "</g>\n")
+
+		ly := marginTop + 10 + 20*i
+		fmt.Fprintf(w, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="%s" stroke-width="2"%s/>`+"\n",
+			plotRight+15, ly, plotRight+35, ly, color, dash)
+		fmt.Fprintf(w, `<text x="%.1f" y="%d">%s</text>`+"\n", plotRight+40, ly+4, html.EscapeString(series.Name))
+	}
+	fmt.Fprintf(w, "</svg>\n")
+	return w.Flush()
+}
+
+// validValues returns the values that can be drawn on a linear or
+// logarithmic axis.
+func validValues(values []float64, log bool) []float64 {
+	a := axis{log: log}
+	var valid []float64
+	for _, v := range values {
+		if a.valid(v) {
+			valid = append(valid, v)
+		}
+	}
+	return valid
+}
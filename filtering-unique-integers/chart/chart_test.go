@@ -0,0 +1,138 @@
+package chart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+)
+
+// checkSVG checks that svg is well-formed XML and returns the number of
+// elements of each name.
+func checkSVG(t *testing.T, svg []byte) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	decoder := xml.NewDecoder(bytes.NewReader(svg))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return counts
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, svg)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	for _, log := range []bool{false, true} {
+		c := &Chart{
+			Title: "A <chart> & more",
+			LogX:  log,
+			LogY:  log,
+			Series: []Series{
+				{Name: "A", Points: []Point{{1, 10, 8, 12}, {10, 100, 90, 110}, {100, 1000, 1000, 1000}}},
+				{Name: "B", Points: []Point{{1, 0, 0, 0}, {10, 50, 40, 60}}},
+			},
+		}
+		var buf bytes.Buffer
+		if err := c.WriteSVG(&buf); err != nil {
+			t.Fatal(err)
+		}
+		counts := checkSVG(t, buf.Bytes())
+		// On log axes the point of B at 0 is left out, leaving a single
+		// point and no line.
+		wantCircles, wantLines := 5, 2
+		if log {
+			wantCircles, wantLines = 4, 1
+		}
+		if counts["circle"] != wantCircles || counts["polyline"] != wantLines {
+			t.Errorf("log=%t: %d circles and %d polylines, want %d and %d", log, counts["circle"], counts["polyline"], wantCircles, wantLines)
+		}
+		if counts["path"] != 3 {
+			t.Errorf("log=%t: %d error bars, want 3", log, counts["path"])
+		}
+	}
+}
+
+func TestSeriesStyle(t *testing.T) {
+	if color, dash := seriesStyle(0); color != palette[0] || dash != "" {
+		t.Errorf("seriesStyle(0) = %q, %q, want %q and a solid line", color, dash, palette[0])
+	}
+	seen := make(map[[2]string]int)
+	for i := range len(palette) * len(dashes) {
+		color, dash := seriesStyle(i)
+		if j, ok := seen[[2]string{color, dash}]; ok {
+			t.Errorf("series %d and %d have the same style %q, %q", j, i, color, dash)
+		}
+		seen[[2]string{color, dash}] = i
+	}
+}
+
+func TestTicks(t *testing.T) {
+	linear := newAxis([]float64{3, 97}, false, 0, 1)
+	if linear.min != 0 || linear.max != 100 {
+		t.Errorf("linear axis [%g, %g], want [0, 100]", linear.min, linear.max)
+	}
+	if ticks := linear.ticks(); len(ticks) != 6 || ticks[1] != 20 {
+		t.Errorf("linear ticks %v, want 0, 20, ..., 100", ticks)
+	}
+	log := newAxis([]float64{3, 97000}, true, 0, 1)
+	if log.min != 1 || log.max != 100000 {
+		t.Errorf("log axis [%g, %g], want [1, 100000]", log.min, log.max)
+	}
+	if ticks := log.ticks(); len(ticks) != 6 {
+		t.Errorf("log ticks %v, want the 6 powers of ten", ticks)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for _, test := range []struct {
+		got, want string
+	}{
+		{FormatNumber(1000000), "1M"},
+		{FormatNumber(20), "20"},
+		{FormatBytes(4096), "4KiB"},
+	} {
+		if test.got != test.want {
+			t.Errorf("got %q, want %q", test.got, test.want)
+		}
+	}
+}
+
+func TestFromRecords(t *testing.T) {
+	env := bench.Environment{Timestamp: time.Unix(0, 0)}
+	records := []bench.Record{
+		{Algorithm: "B", Size: 100, Distribution: "random", MeanNs: 1000, CILowNs: 900, CIHighNs: 1100, Bytes: 64, Environment: env},
+		{Algorithm: "A", Size: 100, Distribution: "random", MeanNs: 500, SamplesNs: []int64{500, 500}, Environment: env},
+		{Algorithm: "A", Size: 100, Distribution: "random", MeanNs: 700, SamplesNs: []int64{700, 700}, Environment: env},
+		{Algorithm: "A", Size: 10, Distribution: "random", MeanNs: 50, Environment: env},
+		{Algorithm: "A", Size: 10, Distribution: "growing", MeanNs: 40, Environment: env},
+	}
+	if got := Distributions(records); strings.Join(got, ",") != "growing,random" {
+		t.Errorf("Distributions = %v", got)
+	}
+
+	c := FromRecords(records, "random", PerElement)
+	if len(c.Series) != 2 || c.Series[0].Name != "A" || c.Series[1].Name != "B" {
+		t.Fatalf("series %+v, want A and B", c.Series)
+	}
+	a := c.Series[0].Points
+	if len(a) != 2 || a[0].X != 10 || a[0].Y != 5 {
+		t.Errorf("points of A %+v, want 5ns per element at size 10 first", a)
+	}
+	// The repeated records of A at size 100 are pooled.
+	if a[1].Y != 6 || a[1].Low >= 6 || a[1].High <= 6 {
+		t.Errorf("pooled point %+v, want 6ns per element with an error bar", a[1])
+	}
+	if b := FromRecords(records, "random", Memory).Series[1].Points[0]; b.Y != 64 || b.Low != 64 {
+		t.Errorf("memory point %+v, want 64 bytes without error bar", b)
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Author: Junior ADI
+Description: Charts of the benchmark records
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package chart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+)
+
+// Kind is the quantity a chart of the records shows against the array size.
+type Kind int
+
+const (
+	// Time is the time of a call on linear axes.
+	Time Kind = iota
+	// TimeLogLog is the time of a call on log-log axes.
+	TimeLogLog
+	// PerElement is the time of a call divided by the array size.
+	PerElement
+	// Memory is the number of bytes allocated by a call.
+	Memory
+)
+
+// Kinds are all the kinds of charts, in order.
+var Kinds = []Kind{Time, TimeLogLog, PerElement, Memory}
+
+var kindNames = []string{"time", "time-loglog", "per-element", "memory"}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// ParseKind returns the kind named name, as printed by Kind.String.
+func ParseKind(name string) (Kind, error) {
+	for i, kindName := range kindNames {
+		if name == kindName {
+			return Kind(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown chart kind %q", name)
+}
+
+// Distributions returns the sorted distinct input distributions of records.
+func Distributions(records []bench.Record) []string {
+	seen := make(map[string]bool)
+	var distributions []string
+	for _, record := range records {
+		if !seen[record.Distribution] {
+			seen[record.Distribution] = true
+			distributions = append(distributions, record.Distribution)
+		}
+	}
+	sort.Strings(distributions)
+	return distributions
+}
+
+// point returns the point of the records measuring one algorithm at one
+// size. The time is the mean of the calls and its error bar is the 95%
+// confidence interval of the mean. Repeated records are merged by pooling
+// their samples, or by averaging their means and taking the envelope of
+// their intervals when some of them have no samples.
+func point(kind Kind, size int, records []bench.Record) Point {
+	p := Point{X: float64(size)}
+	if kind == Memory {
+		for _, record := range records {
+			p.Y += float64(record.Bytes)
+		}
+		p.Y /= float64(len(records))
+		p.Low, p.High = p.Y, p.Y
+		return p
+	}
+
+	var samples []float64
+	pooled := true
+	p.Low, p.High = math.Inf(1), math.Inf(-1)
+	for _, record := range records {
+		if len(record.SamplesNs) == 0 {
+			pooled = false
+		}
+		for _, sample := range record.SamplesNs {
+			samples = append(samples, float64(sample))
+		}
+		p.Y += float64(record.MeanNs)
+		p.Low = math.Min(p.Low, float64(record.CILowNs))
+		p.High = math.Max(p.High, float64(record.CIHighNs))
+	}
+	p.Y /= float64(len(records))
+	if pooled && len(records) > 1 {
+		summary := bench.Summarize(samples)
+		p.Y, p.Low, p.High = summary.Mean, summary.CILow, summary.CIHigh
+	}
+	if kind == PerElement && size > 0 {
+		p.Y /= float64(size)
+		p.Low /= float64(size)
+		p.High /= float64(size)
+	}
+	return p
+}
+
+// FromRecords returns the chart of kind for the measured records of the
+// given input distribution, with one series per algorithm sorted by name.
+// The projected and skipped records are left out, and so are the failed
+// records without any run.
+func FromRecords(records []bench.Record, distribution string, kind Kind) *Chart {
+	type key struct {
+		algorithm string
+		size      int
+	}
+	groups := make(map[key][]bench.Record)
+	var keys []key
+	for _, record := range records {
+		if record.Distribution != distribution || record.Projected || record.Skipped != "" || record.Failure != "" && record.Runs == 0 {
+			continue
+		}
+		k := key{record.Algorithm, record.Size}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], record)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].algorithm != keys[j].algorithm {
+			return keys[i].algorithm < keys[j].algorithm
+		}
+		return keys[i].size < keys[j].size
+	})
+
+	c := &Chart{
+		XLabel:  "Array size",
+		YLabel:  "Time per call",
+		XFormat: FormatNumber,
+		YFormat: bench.FormatNs,
+	}
+	switch kind {
+	case Time:
+		c.Title = fmt.Sprintf("Time vs array size (%s)", distribution)
+	case TimeLogLog:
+		c.Title = fmt.Sprintf("Time vs array size, log-log (%s)", distribution)
+		c.LogX, c.LogY = true, true
+	case PerElement:
+		c.Title = fmt.Sprintf("Time per element (%s)", distribution)
+		c.YLabel = "Time per element"
+		c.LogX = true
+	case Memory:
+		c.Title = fmt.Sprintf("Memory vs array size (%s)", distribution)
+		c.YLabel = "Bytes allocated per call"
+		c.YFormat = FormatBytes
+		c.LogX = true
+	}
+	for _, k := range keys {
+		if n := len(c.Series); n == 0 || c.Series[n-1].Name != k.algorithm {
+			c.Series = append(c.Series, Series{Name: k.algorithm})
+		}
+		series := &c.Series[len(c.Series)-1]
+		series.Points = append(series.Points, point(kind, k.size, groups[k]))
+	}
+	return c
+}
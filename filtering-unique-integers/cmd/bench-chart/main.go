@@ -0,0 +1,113 @@
+/*
+Author: Junior ADI
+Description: SVG charts of the benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command bench-chart draws the JSON or CSV results of
+// best-unique-integers-filter as SVG charts, one file per chart kind and
+// input distribution, with one series per algorithm.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/chart"
+)
+
+// parseKinds parses a comma-separated list of chart kinds.
+func parseKinds(list string) ([]chart.Kind, error) {
+	var kinds []chart.Kind
+	for _, name := range strings.Split(list, ",") {
+		kind, err := chart.ParseKind(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+// writeChart writes c as an SVG image to path.
+func writeChart(path string, c *chart.Chart) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = c.WriteSVG(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func main() {
+	dir := flag.String("o", ".", "output `directory` of the charts")
+	kindList := flag.String("kinds", "time,time-loglog,per-element,memory", "comma-separated chart `kinds`")
+	distribution := flag.String("dist", "", "input `distribution` to draw (default all)")
+	width := flag.Int("width", 800, "chart width in pixels")
+	height := flag.Int("height", 500, "chart height in pixels")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: bench-chart [flags] results.json|results.csv...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	kinds, err := parseKinds(*kindList)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// Records of several files are merged, so that repeated runs of the
+	// benchmark give the error bars of their pooled samples.
+	var records []bench.Record
+	for _, path := range flag.Args() {
+		fileRecords, err := bench.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		records = append(records, fileRecords...)
+	}
+	distributions := chart.Distributions(records)
+	if *distribution != "" {
+		distributions = []string{*distribution}
+	}
+
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for _, dist := range distributions {
+		for _, kind := range kinds {
+			c := chart.FromRecords(records, dist, kind)
+			if len(c.Series) == 0 {
+				fmt.Fprintf(os.Stderr, "no results for distribution %q\n", dist)
+				os.Exit(1)
+			}
+			c.Width, c.Height = *width, *height
+			path := filepath.Join(*dir, fmt.Sprintf("%s-%s.svg", dist, kind))
+			if err := writeChart(path, c); err != nil {
+				fmt.Fprintln(os.Stderr, "Error writing chart:", err)
+				os.Exit(1)
+			}
+			fmt.Println("Chart saved in", path)
+		}
+	}
+}
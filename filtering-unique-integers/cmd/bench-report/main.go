@@ -0,0 +1,64 @@
+/*
+Author: Junior ADI
+Description: HTML report of the benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command bench-report turns the JSON or CSV results of
+// best-unique-integers-filter into a single self-contained HTML file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/report"
+)
+
+func main() {
+	output := flag.String("o", "benchmark_report.html", "output `file`")
+	title := flag.String("title", "Unique integers filters benchmark", "report `title`")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: bench-report [flags] results.json|results.csv...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var records []bench.Record
+	for _, path := range flag.Args() {
+		fileRecords, err := bench.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		records = append(records, fileRecords...)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = report.Write(file, *title, records)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing report:", err)
+		os.Exit(1)
+	}
+	fmt.Println("Report saved in", *output)
+}
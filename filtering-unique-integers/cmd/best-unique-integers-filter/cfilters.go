@@ -0,0 +1,30 @@
+//go:build cgo && cfilters
+
+/*
+Author: Junior ADI
+Description: C filters of the benchmark, built in with the cfilters tag
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package main
+
+import (
+	"strings"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/cfilters"
+)
+
+// The C filters are measured beside the Go ones, and their outputs are
+// checked against the outputs of their Go ports.
+func init() {
+	goPort = func(name string) (string, bool) {
+		return strings.CutPrefix(name, cfilters.Prefix)
+	}
+}
@@ -0,0 +1,769 @@
+/******************************************************************************
+
+                            Author: Junior ADI
+				Description: Brief description of the code file
+				    Date: April 8th 2024, 12:17 AM GMT
+						Location: Abidjan, Cote d'Ivoire.
+						e-mail: rootoor.projects@gmail.com
+						Github: https://github.com/junior-adi/
+
+    Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+					This code is licensed under the MIT License.
+
+                        Copyright (c) 2024, Junior ADI
+
+*******************************************************************************/
+
+// Command best-unique-integers-filter benchmarks every filter over a range
+// of array sizes and saves the timings in benchmark_results.txt.
+//
+// Every input is generated from a seed saved with its results, so that
+// -replay can measure an entry again on exactly the same input.
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/dataset"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+// selectFilters returns the registered filters named in the comma-separated
+// list names, or every registered filter if names is empty.
+func selectFilters(names string) ([]uniqueints.Filter, error) {
+	if names == "" {
+		return uniqueints.Filters(), nil
+	}
+	var filters []uniqueints.Filter
+	for _, name := range strings.Split(names, ",") {
+		filter, ok := uniqueints.Lookup(strings.TrimSpace(name))
+		if !ok {
+			return nil, fmt.Errorf("unknown algorithm %q, known algorithms: %s", name, strings.Join(uniqueints.Names(), ", "))
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// memoryLimits are the largest inputs given to the filters whose memory
+// grows with the values rather than with their number.
+type memoryLimits struct {
+	// maxBlockBytes is the largest memory that the 65536-wide blocks of
+	// values touched by an input may take in a MemoryBlocks filter, as given
+	// by its BlockBytes.
+	maxBlockBytes int
+	// maxSpan is the largest difference between the max and min values of
+	// an input of the MemorySpan filters.
+	maxSpan int
+}
+
+// defaultLimits are the default memory limits: 1 GiB of blocks, which is
+// 2048 blocks of FilterUniqueElementsHT, and a span of 2³¹ values, whose
+// bitmap in a MemorySpan filter takes 256 MiB.
+var defaultLimits = memoryLimits{maxBlockBytes: 1 << 30, maxSpan: 1 << 31}
+
+// countBlocks returns the number of 65536-wide blocks of values touched by
+// input.
+func countBlocks(input []int) int {
+	blocks := make(map[int]bool)
+	for _, value := range input {
+		blocks[value>>16] = true
+	}
+	return len(blocks)
+}
+
+// supports returns why filter cannot run on input: a value outside the
+// range it supports, or an input needing more memory than limits allow. It
+// returns an empty string if filter can run on input.
+func supports(filter uniqueints.Filter, input []int, limits memoryLimits) string {
+	info := filter.Info()
+	for _, elem := range input {
+		if !info.Range.Contains(elem) {
+			return fmt.Sprintf("value %d outside the supported range %v", elem, info.Range)
+		}
+	}
+	switch {
+	case len(input) == 0:
+	case info.Memory == uniqueints.MemoryBlocks:
+		if blocks := countBlocks(input); blocks*info.BlockBytes > limits.maxBlockBytes {
+			return fmt.Sprintf("input touches %d blocks of values of %d bytes each, more than -max-block-memory %d", blocks, info.BlockBytes, limits.maxBlockBytes)
+		}
+	case info.Memory == uniqueints.MemorySpan:
+		if span := slices.Max(input) - slices.Min(input); span > limits.maxSpan {
+			return fmt.Sprintf("input spans %d values, more than -max-span %d", span, limits.maxSpan)
+		}
+	}
+	return ""
+}
+
+// verify returns how got, the output of filter, differs from want, the
+// output of the reference filter, or an empty string if it does not. The
+// outputs are compared as sets when filter does not preserve the order.
+func verify(filter uniqueints.Filter, got, want []int) string {
+	if !filter.Info().OrderPreserving {
+		got = slices.Clone(got)
+		want = slices.Clone(want)
+		slices.Sort(got)
+		slices.Sort(want)
+	}
+	if len(got) != len(want) {
+		return fmt.Sprintf("got %d unique values, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return fmt.Sprintf("value %d is %d, want %d", i, got[i], want[i])
+		}
+	}
+	return ""
+}
+
+// listFilters prints the registered filters with their metadata.
+func listFilters() {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tORDERED\tRANGE\tMEMORY\tTIME\tTHREAD-SAFE")
+	for _, filter := range uniqueints.Filters() {
+		info := filter.Info()
+		fmt.Fprintf(w, "%s\t%t\t%v\t%v\t%v\t%t\n", info.Name, info.OrderPreserving, info.Range, info.Memory, info.Time, info.ThreadSafe)
+	}
+	w.Flush()
+}
+
+// defaultSizes are the array sizes to test.
+const defaultSizes = "10,20,30,40,50,60,70,80,90,100,200,300,400,500,600,700,800,900,1000,2000,3000,4000,5000,6000,7000,8000,9000,10000,50000,100000,200000,300000,400000,500000"
+
+// largeSizes are the array sizes added by the -large flag. The quadratic
+// algorithms would run for hours at these sizes: they are only measured
+// until they exceed the time budget, and projected beyond.
+const largeSizes = "600000,700000,800000,900000,1000000,2000000,3000000,4000000,5000000,6000000,7000000,8000000,9000000,10000000"
+
+// parseSizes parses a comma-separated list of array sizes, and returns them
+// sorted.
+func parseSizes(list string) ([]int, error) {
+	var sizes []int
+	for _, field := range strings.Split(list, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || size < 1 {
+			return nil, fmt.Errorf("invalid array size %q", field)
+		}
+		sizes = append(sizes, size)
+	}
+	slices.Sort(sizes)
+	return sizes, nil
+}
+
+// growthExponent returns the exponent of the growth curve of an algorithm
+// of the given complexity, used when it was measured at a single size.
+func growthExponent(class uniqueints.TimeClass) float64 {
+	if class == uniqueints.TimeQuadratic {
+		return 2
+	}
+	// log n barely changes over the benchmarked sizes.
+	return 1
+}
+
+// progress is the state of the benchmark of one algorithm over the sizes.
+type progress struct {
+	// sizes and ns are the sizes measured so far and their median times.
+	sizes []int
+	ns    []float64
+	// overBudget tells whether the algorithm exceeded the time budget, and
+	// growth is then its growth curve, nil if it could not be fitted.
+	overBudget bool
+	growth     *bench.Growth
+}
+
+// benchInput is an input array of the benchmark, with what is needed to
+// generate it again.
+type benchInput struct {
+	values []int
+	// want is the output of the reference filter on values.
+	want         []int
+	size         int
+	distribution string
+	// seed is the seed values were generated from, 0 for the sample array.
+	seed uint64
+}
+
+// sampleInput is the input of the legacy benchmark at size 10.
+var sampleInput = []int{16, 17, 2, 17, 4, 2, 97, 4, 17, 56}
+
+// newBenchInput returns the input of the given values, with the output of
+// the reference filter.
+func newBenchInput(values []int, distribution string, seed uint64) benchInput {
+	return benchInput{
+		values:       values,
+		want:         uniqueints.FilterUniqueElementsHashTable(values),
+		size:         len(values),
+		distribution: distribution,
+		seed:         seed,
+	}
+}
+
+// benchmarkInput returns the input array of the given distribution and
+// size, generated from seed by dataset.Generate. At size 10, the "random"
+// distribution of the legacy benchmark is the sample array instead,
+// recorded as the "sample" distribution without a seed.
+func benchmarkInput(distribution string, size int, seed uint64) (benchInput, error) {
+	if distribution == "random" && size == len(sampleInput) {
+		return newBenchInput(slices.Clone(sampleInput), "sample", 0), nil
+	}
+	d, err := dataset.Generate(distribution, size, seed)
+	if err != nil {
+		return benchInput{}, err
+	}
+	return newBenchInput(d.Values, distribution, seed), nil
+}
+
+// replayInput generates the input of record again from its distribution
+// and seed.
+func replayInput(record bench.Record) (benchInput, error) {
+	switch {
+	case record.Distribution == "sample":
+		if record.Size != len(sampleInput) {
+			return benchInput{}, fmt.Errorf("sample input of size %d, want %d", record.Size, len(sampleInput))
+		}
+		return benchmarkInput("random", record.Size, 0)
+	case record.Seed == 0:
+		return benchInput{}, fmt.Errorf("%s input of size %d has no seed", record.Distribution, record.Size)
+	}
+	return benchmarkInput(record.Distribution, record.Size, record.Seed)
+}
+
+// datasetInput returns the input read from the dataset file at path,
+// recorded with the distribution and the seed of its header, or with the
+// name of the file when the header has no distribution.
+func datasetInput(path string) (benchInput, error) {
+	d, _, err := dataset.ReadFile(path)
+	if err != nil {
+		return benchInput{}, err
+	}
+	if d.Distribution == "" {
+		d.Distribution = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return newBenchInput(d.Values, d.Distribution, d.Seed), nil
+}
+
+// benchmark holds the state of a benchmark run.
+type benchmark struct {
+	runner bench.Runner
+	env    bench.Environment
+	limits memoryLimits
+	// progresses are the progress of every algorithm and distribution.
+	progresses map[string]*progress
+	records    []bench.Record
+}
+
+// goPort returns the registered name of the Go port of the filter named
+// name when it is a C filter. It is set when the cfilters package is built
+// in, with the cfilters build tag.
+var goPort = func(name string) (string, bool) { return "", false }
+
+// portMismatch starts the failure added to the record of a C filter whose
+// output differs from the output of its Go port, after its other failures.
+const portMismatch = "differs from the Go port "
+
+// panicError is the error of a filter that panicked, such as a C filter
+// whose allocation failed.
+type panicError struct {
+	value any
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// filterContext runs filter on input like uniqueints.FilterContext, and
+// returns a *panicError if filter panics.
+func filterContext(ctx context.Context, filter uniqueints.Filter, input []int) (output []int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			output, err = nil, &panicError{r}
+		}
+	}()
+	return uniqueints.FilterContext(ctx, filter, input)
+}
+
+// addFailure adds failure to the failures of record, separated by "; ".
+func addFailure(record *bench.Record, failure string) {
+	if record.Failure != "" {
+		failure = record.Failure + "; " + failure
+	}
+	record.Failure = failure
+}
+
+// newBenchmark returns a benchmark measuring with runner, skipping the
+// inputs beyond limits.
+func newBenchmark(runner bench.Runner, env bench.Environment, limits memoryLimits) *benchmark {
+	return &benchmark{runner: runner, env: env, limits: limits, progresses: make(map[string]*progress)}
+}
+
+// measure measures filter on in and adds its record. Once the algorithm
+// exceeded the time budget on the distribution, its time is projected from
+// its growth curve instead. It returns ctx.Err() if ctx is done.
+func (b *benchmark) measure(ctx context.Context, filter uniqueints.Filter, in benchInput) error {
+	info := filter.Info()
+	key := info.Name + "/" + in.distribution
+	p := b.progresses[key]
+	if p == nil {
+		p = &progress{}
+		b.progresses[key] = p
+	}
+	if p.overBudget {
+		if p.growth != nil {
+			b.records = append(b.records, bench.NewProjectedRecord(info.Name, in.size, in.distribution, in.seed, p.growth.At(in.size), b.env))
+		}
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "Benchmark for %s algorithm, array size %d, distribution %s\n", info.Name, in.size, in.distribution)
+
+	// Repeat the measurement until it is precise enough.
+	var output []int
+	var panicked error
+	measurement, err := b.runner.MeasureContext(ctx, func(ctx context.Context) error {
+		got, err := filterContext(ctx, filter, in.values)
+		if err != nil {
+			if _, ok := err.(*panicError); ok {
+				panicked = err
+			}
+			return err
+		}
+		output = got
+		return nil
+	})
+	if panicked != nil {
+		record := bench.NewRecord(info.Name, in.size, in.distribution, in.seed, measurement, b.env)
+		record.Failure = panicked.Error()
+		fmt.Fprintf(os.Stderr, "%s algorithm failed on array size %d, distribution %s: %s\n", info.Name, in.size, in.distribution, record.Failure)
+		b.records = append(b.records, record)
+		return nil
+	}
+	if errors.Is(err, bench.ErrOverBudget) {
+		p.overBudget = true
+		growth, err := bench.FitGrowth(p.sizes, p.ns, growthExponent(info.Time))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s algorithm exceeded the time budget at array size %d, distribution %s, skipping the larger sizes\n", info.Name, in.size, in.distribution)
+			return nil
+		}
+		p.growth = &growth
+		fmt.Fprintf(os.Stderr, "%s algorithm exceeded the time budget at array size %d, distribution %s, projecting the larger sizes as %.3g ns × n^%.2f\n",
+			info.Name, in.size, in.distribution, growth.Coef, growth.Exp)
+		b.records = append(b.records, bench.NewProjectedRecord(info.Name, in.size, in.distribution, in.seed, growth.At(in.size), b.env))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	record := bench.NewRecord(info.Name, in.size, in.distribution, in.seed, measurement, b.env)
+	if record.Failure = verify(filter, output, in.want); record.Failure != "" {
+		fmt.Fprintf(os.Stderr, "%s algorithm is incorrect on array size %d, distribution %s: %s\n", info.Name, in.size, in.distribution, record.Failure)
+	}
+	if name, ok := goPort(info.Name); ok {
+		port, _ := uniqueints.Lookup(name)
+		portOutput, err := filterContext(context.Background(), port, in.values)
+		diff := verify(filter, output, portOutput)
+		if err != nil {
+			diff = "Go port failed: " + err.Error()
+		}
+		if diff != "" {
+			addFailure(&record, portMismatch+name+": "+diff)
+			fmt.Fprintf(os.Stderr, "%s algorithm differs from the Go port %s on array size %d, distribution %s: %s\n", info.Name, name, in.size, in.distribution, diff)
+		}
+	}
+	b.records = append(b.records, record)
+	p.sizes = append(p.sizes, in.size)
+	p.ns = append(p.ns, measurement.Summary.Median)
+	return nil
+}
+
+// measureFilters measures each of filters on in, and adds a skipped record
+// for the filters that cannot run on it. It returns ctx.Err() if ctx is
+// done.
+func (b *benchmark) measureFilters(ctx context.Context, filters []uniqueints.Filter, in benchInput) error {
+	// Loop over each filter
+	for _, filter := range filters {
+		if reason := supports(filter, in.values, b.limits); reason != "" {
+			name := filter.Info().Name
+			fmt.Fprintf(os.Stderr, "Skipping %s algorithm on array size %d, distribution %s: %s\n", name, in.size, in.distribution, reason)
+			b.records = append(b.records, bench.NewSkippedRecord(name, in.size, in.distribution, in.seed, reason, b.env))
+			continue
+		}
+		if err := b.measure(ctx, filter, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runBenchmark measures filters on each distribution at each of sizes, in
+// increasing order. Once an algorithm exceeds the time budget of the runner
+// on a distribution, it is not measured at the larger sizes anymore: their
+// times are projected from its growth curve. Each input is generated from
+// its own seed, drawn from seed, so that it can be replayed from its
+// records alone. It returns the records measured so far and the error
+// when the measurement fails, ctx.Err() when ctx is done.
+func runBenchmark(ctx context.Context, filters []uniqueints.Filter, sizes []int, distributions []string, seed uint64, runner bench.Runner, env bench.Environment, limits memoryLimits) ([]bench.Record, error) {
+	b := newBenchmark(runner, env, limits)
+	seeds := generator.NewRand(seed)
+
+	// Loop over each array size
+	for _, size := range sizes {
+		for _, distribution := range distributions {
+			in, err := benchmarkInput(distribution, size, generator.NewSeed(seeds))
+			if err != nil {
+				return nil, err
+			}
+			if err := b.measureFilters(ctx, filters, in); err != nil {
+				return b.records, err
+			}
+		}
+	}
+	return b.records, nil
+}
+
+// runDatasets measures filters on the inputs of the dataset files at paths,
+// in increasing size, like runBenchmark. A single input is in memory at
+// once.
+func runDatasets(ctx context.Context, filters []uniqueints.Filter, paths []string, runner bench.Runner, env bench.Environment, limits memoryLimits) ([]bench.Record, error) {
+	sizes := make(map[string]uint64)
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		h, err := dataset.ReadHeader(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		sizes[path] = h.Count
+	}
+	paths = slices.Clone(paths)
+	slices.SortStableFunc(paths, func(a, b string) int { return cmp.Compare(sizes[a], sizes[b]) })
+
+	b := newBenchmark(runner, env, limits)
+	for _, path := range paths {
+		in, err := datasetInput(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.measureFilters(ctx, filters, in); err != nil {
+			return b.records, err
+		}
+	}
+	return b.records, nil
+}
+
+// runReplay measures again the algorithm of each of records on its input,
+// generated again from its seed. It returns the records measured so far
+// and the error when the measurement fails, ctx.Err() when ctx is done.
+func runReplay(ctx context.Context, records []bench.Record, runner bench.Runner, env bench.Environment, limits memoryLimits) ([]bench.Record, error) {
+	b := newBenchmark(runner, env, limits)
+	for _, record := range records {
+		filter, ok := uniqueints.Lookup(record.Algorithm)
+		if !ok {
+			return nil, fmt.Errorf("unknown algorithm %q, known algorithms: %s", record.Algorithm, strings.Join(uniqueints.Names(), ", "))
+		}
+		in, err := replayInput(record)
+		if err != nil {
+			return nil, fmt.Errorf("%s algorithm: %w", record.Algorithm, err)
+		}
+		if err := b.measureFilters(ctx, []uniqueints.Filter{filter}, in); err != nil {
+			return b.records, err
+		}
+	}
+	return b.records, nil
+}
+
+// selectEntries returns the records to replay: the record at index entry,
+// or every record if entry is negative, of the algorithms named in the
+// comma-separated list names, or of every algorithm if names is empty.
+func selectEntries(records []bench.Record, entry int, names string) ([]bench.Record, error) {
+	if entry >= len(records) {
+		return nil, fmt.Errorf("entry %d out of range, the file has %d entries", entry, len(records))
+	}
+	if entry >= 0 {
+		records = records[entry : entry+1]
+	}
+	if names == "" {
+		return records, nil
+	}
+	var selected []bench.Record
+	for _, record := range records {
+		for _, name := range strings.Split(names, ",") {
+			if strings.TrimSpace(name) == record.Algorithm {
+				selected = append(selected, record)
+				break
+			}
+		}
+	}
+	return selected, nil
+}
+
+// dumpInput writes the values of in to path, as a dataset file if path
+// ends in .fuid, and one per line otherwise.
+func dumpInput(path string, in benchInput) error {
+	if filepath.Ext(path) == ".fuid" {
+		return dataset.WriteFile(path, dataset.Dataset{Distribution: in.distribution, Seed: in.seed, Values: in.values}, dataset.Raw)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(file)
+	for _, value := range in.values {
+		fmt.Fprintln(w, value)
+	}
+	err = w.Flush()
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// parseDistributions parses a comma-separated list of distributions: random
+// or the names accepted by generator.Lookup, or all for random and every
+// one of generator.Names.
+func parseDistributions(list string) ([]string, error) {
+	if list == "all" {
+		return append([]string{"random"}, generator.Names()...), nil
+	}
+	var distributions []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := generator.Lookup(name); !ok && name != "random" {
+			return nil, fmt.Errorf("unknown distribution %q, known distributions: random, %s, or dup-<ratio>-<profile>", name, strings.Join(generator.Names(), ", "))
+		}
+		distributions = append(distributions, name)
+	}
+	return distributions, nil
+}
+
+// parseDuplicateRatios parses a comma-separated list of duplicate ratios,
+// and returns the names of their distributions with the multiplicity
+// profile named profile.
+func parseDuplicateRatios(list, profile string) ([]string, error) {
+	p, err := generator.ParseProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	var distributions []string
+	for _, field := range strings.Split(list, ",") {
+		ratio, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid duplicate ratio %q, want a fraction between 0 and 1", field)
+		}
+		distributions = append(distributions, generator.DuplicateName(ratio, p))
+	}
+	return distributions, nil
+}
+
+// flagSet reports whether the flag named name was set on the command line.
+func flagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// writeResults writes records to path in the given format.
+func writeResults(path, format string, records []bench.Record, env bench.Environment) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "text":
+		err = bench.WriteText(file, records, env)
+	case "json":
+		err = bench.WriteJSON(file, records)
+	case "csv":
+		err = bench.WriteCSV(file, records)
+	default:
+		err = fmt.Errorf("unknown format %q, want text, json or csv", format)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func main() {
+	algorithms := flag.String("algorithms", "", "comma-separated `names` of the algorithms to benchmark (default all)")
+	list := flag.Bool("list", false, "list the registered algorithms and exit")
+	sizeList := flag.String("sizes", defaultSizes, "comma-separated array `sizes` to test")
+	distributionList := flag.String("distributions", "random", "comma-separated input `distributions`: random (the legacy inputs), the names of the generator package, dup-<ratio>-<profile>, or all")
+	large := flag.Bool("large", false, "also test the sizes from 600000 to 10000000")
+	duplicateRatios := flag.String("duplicate-ratios", "", "comma-separated duplicate `ratios` to sweep, each adding the distribution of arrays with that fraction of duplicates, instead of the default -distributions")
+	profile := flag.String("profile", "geometric", "multiplicity `profile` of the -duplicate-ratios distributions: fixed, geometric or power-law")
+	seed := flag.Uint64("seed", 0, "seed the seeds of the inputs are drawn from, random if 0")
+	replay := flag.String("replay", "", "measure again the entries of the JSON or CSV results `file`, on the same inputs")
+	entry := flag.Int("entry", -1, "index of the single entry of the -replay file to measure again (default all)")
+	dump := flag.String("dump", "", "write the input of the -replay -entry to `file`, as a dataset file if it ends in .fuid and one value per line otherwise, instead of measuring it")
+	datasets := flag.String("dataset", "", "comma-separated dataset `files` to measure instead of generating the inputs")
+	format := flag.String("format", "text", "output `format`: text, json or csv")
+	output := flag.String("o", "", "output `file` (default benchmark_results.txt, .json or .csv depending on the format)")
+	runner := bench.DefaultRunner
+	flag.IntVar(&runner.Warmup, "warmup", runner.Warmup, "number of warmup batches before measuring")
+	flag.IntVar(&runner.MinRuns, "min-runs", runner.MinRuns, "minimum number of samples per measurement")
+	flag.IntVar(&runner.MaxRuns, "max-runs", runner.MaxRuns, "maximum number of samples per measurement")
+	flag.Float64Var(&runner.TargetRelErr, "target-rel-err", runner.TargetRelErr, "stop sampling once the 95% confidence interval is within this fraction of the mean")
+	flag.DurationVar(&runner.TimeBudget, "time-budget", runner.TimeBudget, "stop sampling a measurement after this time, and project the times of an algorithm whose single call exceeds it, 0 for no budget")
+	flag.DurationVar(&runner.MinSampleTime, "min-sample-time", runner.MinSampleTime, "minimum duration of a sample, reached by batching calls")
+	limits := defaultLimits
+	flag.IntVar(&limits.maxBlockBytes, "max-block-memory", limits.maxBlockBytes, "skip the filters whose memory grows with the 65536-wide blocks of values on inputs whose blocks would take more `bytes`")
+	flag.IntVar(&limits.maxSpan, "max-span", limits.maxSpan, "skip the filters whose memory grows with the span of the values on inputs whose max and min values differ by more than `span`")
+	flag.Parse()
+
+	if *list {
+		listFilters()
+		return
+	}
+
+	var filters []uniqueints.Filter
+	var sizes []int
+	var distributions []string
+	var replayed []bench.Record
+	var err error
+	if *replay != "" {
+		replayed, err = bench.ReadFile(*replay)
+		if err == nil {
+			replayed, err = selectEntries(replayed, *entry, *algorithms)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if *datasets != "" {
+			fmt.Fprintln(os.Stderr, "-dataset and -replay are exclusive")
+			os.Exit(2)
+		}
+	} else {
+		if *entry >= 0 || *dump != "" {
+			fmt.Fprintln(os.Stderr, "-entry and -dump need -replay")
+			os.Exit(2)
+		}
+		filters, err = selectFilters(*algorithms)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if *large {
+			*sizeList += "," + largeSizes
+		}
+		sizes, err = parseSizes(*sizeList)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		distributions, err = parseDistributions(*distributionList)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		if *duplicateRatios != "" {
+			ratios, err := parseDuplicateRatios(*duplicateRatios, *profile)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(2)
+			}
+			if !flagSet("distributions") {
+				distributions = nil
+			}
+			distributions = append(distributions, ratios...)
+		}
+	}
+
+	if *dump != "" {
+		if *entry < 0 {
+			fmt.Fprintln(os.Stderr, "-dump needs -entry")
+			os.Exit(2)
+		}
+		if len(replayed) == 0 {
+			fmt.Fprintf(os.Stderr, "entry %d is not of the selected algorithms\n", *entry)
+			os.Exit(2)
+		}
+		in, err := replayInput(replayed[0])
+		if err == nil {
+			err = dumpInput(*dump, in)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error writing input:", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Input of %s algorithm, array size %d, distribution %s, seed %d saved in %s\n",
+			replayed[0].Algorithm, in.size, in.distribution, in.seed, *dump)
+		return
+	}
+
+	ext := *format
+	switch ext {
+	case "text":
+		ext = "txt"
+	case "json", "csv":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q, want text, json or csv\n", *format)
+		os.Exit(2)
+	}
+	path := *output
+	if path == "" {
+		path = "benchmark_results." + ext
+	}
+
+	// Interrupting the benchmark saves the results measured so far.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	env := bench.CurrentEnvironment()
+	var records []bench.Record
+	if *replay != "" {
+		records, err = runReplay(ctx, replayed, runner, env, limits)
+	} else if *datasets != "" {
+		records, err = runDatasets(ctx, filters, strings.Split(*datasets, ","), runner, env, limits)
+	} else {
+		if *seed == 0 {
+			*seed = generator.NewSeed(nil)
+		}
+		fmt.Fprintf(os.Stderr, "Seed: %d\n", *seed)
+		records, err = runBenchmark(ctx, filters, sizes, distributions, *seed, runner, env, limits)
+	}
+	if errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, "Interrupted, saving the results measured so far")
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := writeResults(path, *format, records, env); err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing results:", err)
+		os.Exit(1)
+	}
+	fmt.Println("Benchmark results saved in", path)
+
+	mismatches := 0
+	for _, record := range records {
+		if strings.Contains(record.Failure, portMismatch) {
+			mismatches++
+		}
+	}
+	if mismatches > 0 {
+		fmt.Fprintf(os.Stderr, "%d outputs of the C filters differ from their Go ports\n", mismatches)
+		os.Exit(1)
+	}
+}
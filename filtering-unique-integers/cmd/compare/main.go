@@ -0,0 +1,86 @@
+/*
+Author: Junior ADI
+Description: Regression comparison of two benchmark result files
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command compare compares two JSON or CSV results of
+// best-unique-integers-filter, for example before and after a change. It
+// prints the change of the median time of every algorithm, size and
+// distribution found in both files with the p-value of the Mann-Whitney U
+// test of their samples, and exits with status 1 when a change is a
+// significant slowdown above the regression threshold.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"text/tabwriter"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+)
+
+func main() {
+	threshold := flag.Float64("threshold", 0.05, "fail on significant slowdowns above this `fraction` of the old median time")
+	alpha := flag.Float64("alpha", 0.05, "significance `level` of the Mann-Whitney U test")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: compare [flags] old.json|old.csv new.json|new.csv\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	before, err := bench.ReadFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	after, err := bench.ReadFile(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	comparisons, unmatched := bench.Compare(before, after)
+	regressions := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ALGORITHM\tDIST\tSIZE\tOLD\tNEW\tDELTA\tP-VALUE\t")
+	for _, c := range comparisons {
+		p := "n/a"
+		if !math.IsNaN(c.P) {
+			p = fmt.Sprintf("%.3g", c.P)
+		}
+		verdict := "~"
+		switch {
+		case c.Regression(*threshold, *alpha):
+			verdict = "REGRESSION"
+			regressions++
+		case c.Significant(*alpha) && c.Delta < 0:
+			verdict = "faster"
+		case c.Significant(*alpha) && c.Delta > 0:
+			verdict = "slower"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%+.1f%%\t%s\t%s\n", c.Algorithm, c.Distribution, c.Size,
+			bench.FormatNs(c.OldNs), bench.FormatNs(c.NewNs), c.Delta*100, p, verdict)
+	}
+	w.Flush()
+	for _, key := range unmatched {
+		fmt.Fprintln(os.Stderr, "not compared:", key)
+	}
+
+	if regressions > 0 {
+		fmt.Printf("%d regressions above %.1f%% at p < %g\n", regressions, *threshold*100, *alpha)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Author: Junior ADI
+Description: Tool of the binary dataset files
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command dataset writes, reads and checksums the binary dataset files of
+// the input arrays:
+//
+//	dataset write -distribution zipf -size 1000000 -seed 42 -o zipf.fuid
+//	dataset info zipf.fuid
+//	dataset dump zipf.fuid
+//	dataset checksum zipf.fuid
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/dataset"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `usage: dataset <command> [arguments]
+
+The commands are:
+  write     generate an input array and write it as a dataset file
+  info      print the header of dataset files
+  dump      print the values of a dataset file, one per line
+  checksum  check the CRC-32 of dataset files
+`)
+}
+
+// write generates a dataset and writes it.
+func write(args []string) int {
+	flags := flag.NewFlagSet("write", flag.ExitOnError)
+	distribution := flags.String("distribution", "random", "input `distribution`: random, the names of the generator package, or dup-<ratio>-<profile>")
+	size := flags.Int("size", 1000000, "number of `values`")
+	seed := flags.Uint64("seed", 0, "seed of the values, random if 0")
+	encodingName := flags.String("encoding", "raw", "`encoding` of the body: raw or delta")
+	output := flags.String("o", "", "output `file` (default <distribution>-<size>.fuid)")
+	flags.Parse(args)
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+	encoding, err := dataset.ParseEncoding(*encodingName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if *seed == 0 {
+		*seed = generator.NewSeed(nil)
+	}
+	d, err := dataset.Generate(*distribution, *size, *seed)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	path := *output
+	if path == "" {
+		path = fmt.Sprintf("%s-%d.fuid", *distribution, *size)
+	}
+	if err := dataset.WriteFile(path, d, encoding); err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing dataset:", err)
+		return 1
+	}
+	fmt.Printf("%d values of distribution %s, seed %d, saved in %s\n", *size, *distribution, *seed, path)
+	return 0
+}
+
+// info prints the header of each file.
+func info(paths []string) int {
+	status := 0
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+		h, err := dataset.ReadHeader(file)
+		file.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			status = 1
+			continue
+		}
+		fmt.Printf("%s: version %d, %d values of %d bytes, %v encoding, distribution %s, seed %d, body %d bytes, CRC-32 %08x\n",
+			path, h.Version, h.Count, h.Width, h.Encoding, h.Distribution, h.Seed, h.BodyLen, h.Checksum)
+	}
+	return status
+}
+
+// dump prints the values of the file at path.
+func dump(path string) int {
+	d, _, err := dataset.ReadFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	w := bufio.NewWriter(os.Stdout)
+	for _, value := range d.Values {
+		fmt.Fprintln(w, value)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// checksum checks the body of each file against the CRC-32 of its header.
+func checksum(paths []string) int {
+	status := 0
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+		h, err := dataset.Verify(file)
+		file.Close()
+		if err != nil {
+			fmt.Printf("%s: %v\n", path, err)
+			status = 1
+			continue
+		}
+		fmt.Printf("%08x  %s\n", h.Checksum, path)
+	}
+	return status
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	command, args := os.Args[1], os.Args[2:]
+	if command != "write" && len(args) == 0 || command == "dump" && len(args) != 1 {
+		usage()
+		os.Exit(2)
+	}
+	switch command {
+	case "write":
+		os.Exit(write(args))
+	case "info":
+		os.Exit(info(args))
+	case "dump":
+		os.Exit(dump(args[0]))
+	case "checksum":
+		os.Exit(checksum(args))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q, want write, info, dump or checksum\n", command)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Author: Junior ADI
+Description: Import of the legacy text benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command import-legacy converts benchmark_results.txt and
+// benchmark_best_results.txt files into the JSON or CSV result format, and
+// prints warnings about suspicious data.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+)
+
+// readLegacyFile reads the records and the warnings of the legacy file at
+// path.
+func readLegacyFile(path string) ([]bench.Record, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+	records, warnings, err := bench.ReadLegacy(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return records, warnings, nil
+}
+
+func main() {
+	format := flag.String("format", "json", "output `format`: json or csv")
+	output := flag.String("o", "", "output `file` (default standard output)")
+	strict := flag.Bool("strict", false, "exit with status 1 when the data is suspicious")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: import-legacy [flags] benchmark_results.txt...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var write func(io.Writer, []bench.Record) error
+	switch *format {
+	case "json":
+		write = bench.WriteJSON
+	case "csv":
+		write = bench.WriteCSV
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q, want json or csv\n", *format)
+		os.Exit(2)
+	}
+
+	var records []bench.Record
+	suspicious := false
+	for _, path := range flag.Args() {
+		fileRecords, warnings, err := readLegacyFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for _, warning := range warnings {
+			fmt.Fprintf(os.Stderr, "%s: warning: %s\n", path, warning)
+			suspicious = true
+		}
+		records = append(records, fileRecords...)
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		out = file
+	}
+	err := write(out, records)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error writing results:", err)
+		os.Exit(1)
+	}
+	if *strict && suspicious {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,135 @@
+/******************************************************************************
+
+                            Author: Junior ADI
+				Description: Brief description of the code file
+				    Date: April 8th 2024, 12:17 AM GMT
+						Location: Abidjan, Cote d'Ivoire.
+
+    Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+					This code is licensed under the MIT License.
+
+                        Copyright (c) 2024, Junior ADI
+
+*******************************************************************************/
+
+// Command unique-integers-filter-improved1 runs every filter on a small
+// sample array and on generated arrays, then prints the output of the
+// input generators. The random arrays are drawn from the seed of the -seed
+// flag, or from a random one printed at the start, to get them again.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+// runFilter runs filter on input and prints the arrays and the execution
+// time.
+func runFilter(filter uniqueints.Filter, input []int) {
+	// Capture the time before and after executing the filter
+	startTime := time.Now()
+	output := filter.Filter(input)
+	duration := time.Since(startTime)
+
+	// displaying arrays
+	fmt.Printf("Original array: %v\n", input)
+	fmt.Printf("Filtered array: %v\n", output)
+	fmt.Printf("Execution time: %v\n", duration)
+}
+
+func main() {
+	seed := flag.Uint64("seed", 0, "seed of the random arrays, random if 0")
+	flag.Parse()
+	if *seed == 0 {
+		*seed = generator.NewSeed(nil)
+	}
+	fmt.Printf("Seed: %d\n\n", *seed)
+	r := generator.NewRand(*seed)
+
+	input := []int{16, 17, 2, 17, 4, 2, 97, 4, 17}
+
+	for _, filter := range uniqueints.Filters() {
+		name := strings.ToUpper(filter.Info().Name)
+		fmt.Printf("%s ALGORITHM START\n", name)
+		runFilter(filter, input)
+		fmt.Printf("%s ALGORITHM END\n\n", name)
+	}
+
+	/*----------------------------------- HUGE SETS TESTING -----------------------------------*/
+
+	fmt.Printf("/*------------------- HUGE SETS TESTING -------------------*/\n")
+
+	var huge_input_arr1 = make([]int, 10)
+	err := uniqueints.GenerateRandomInputArr(r, huge_input_arr1, 10, 100)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	var huge_input_arr2 = make([]int, 10)
+	err = uniqueints.GenerateGrowingArr(huge_input_arr2, 10)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	for _, filter := range uniqueints.Filters() {
+		name := strings.ToUpper(filter.Info().Name)
+		fmt.Printf("HUGE SETS TESTING WITH %s ALGORITHM START\n", name)
+
+		fmt.Printf("GENERATED RANDOM HUGE SET WITH GenerateRandomInputArr() ALGORITHM\n")
+		runFilter(filter, huge_input_arr1)
+
+		fmt.Printf("GENERATED RANDOM HUGE SET WITH GenerateGrowingArr() ALGORITHM\n")
+		runFilter(filter, huge_input_arr2)
+
+		fmt.Printf("HUGE SETS TESTING WITH %s ALGORITHM END\n\n", name)
+	}
+
+	/* TESTING NEW GENERATOR METHODS */
+
+	fmt.Println("random arrays")
+
+	// Generate a random array of integers with 10 elements and a maximum random value of 100
+	arr1 := make([]int, 10)
+	err1 := uniqueints.GenerateRandomInputArrImproved(r, arr1, 10, 100)
+	if err1 != nil {
+		fmt.Println(err1)
+	} else {
+		fmt.Println(arr1)
+	}
+
+	// Generate a growing array of integers with 10 elements
+	arr2 := make([]int, 10)
+	err2 := uniqueints.GenerateGrowingArrImproved(arr2, 10)
+	if err2 != nil {
+		fmt.Println(err2)
+	} else {
+		fmt.Println(arr2)
+	}
+
+	// Generate a random array of integers with 10 elements, a maximum random value of 100, and 5 duplicates
+	arr3 := make([]int, 10)
+	err3 := uniqueints.GenerateRandomInputArrImproved2(r, arr3, 10, 100, 5)
+	if err3 != nil {
+		fmt.Println(err3)
+	} else {
+		fmt.Println(arr3)
+	}
+
+	// Generate a growing array of integers with 10 elements and 5 duplicates
+	arr4 := make([]int, 10)
+	err4 := uniqueints.GenerateGrowingArrImproved2(arr4, 10, 5)
+	if err4 != nil {
+		fmt.Println(err4)
+	} else {
+		fmt.Println(arr4)
+	}
+
+}
@@ -0,0 +1,43 @@
+/*
+Author: Junior ADI
+Description: Brief description of the code file
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Command unique-integers-filter runs every filter on a small sample array
+// and prints the filtered array with its execution time.
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+func main() {
+	input := []int{16, 17, 2, 17, 4, 2, 97, 4, 17}
+
+	for _, filter := range uniqueints.Filters() {
+		name := strings.ToUpper(filter.Info().Name)
+		fmt.Printf("%s ALGORITHM START\n", name)
+		// Capture the time before and after executing the filter
+		startTime := time.Now()
+		output := filter.Filter(input)
+		duration := time.Since(startTime)
+
+		// displaying arrays
+		fmt.Printf("Original array: %v\n", input)
+		fmt.Printf("Filtered array: %v\n", output)
+		fmt.Printf("Execution time: %v\n", duration)
+		fmt.Printf("%s ALGORITHM END\n\n", name)
+	}
+}
@@ -0,0 +1,324 @@
+/*
+Author: Junior ADI
+Description: Binary dataset files of the input arrays
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package dataset reads and writes input arrays as binary files, so that
+// the benchmarks of the Go filters and of the C filters, which the
+// benchmark command calls through the cfilters package, run on the same
+// inputs. Only Go reads the files: unique-integers-filter.c generates its
+// own inputs, and a reader in another language follows the layout below.
+//
+// A file is a header followed by a body. Every integer of the header is
+// little-endian:
+//
+//	offset  size  field
+//	0       4     magic "FUID"
+//	4       2     version, 1
+//	6       1     width of an element in bytes, 4 or 8
+//	7       1     encoding of the body: 0 raw, 1 delta
+//	8       8     number of elements
+//	16      8     seed the elements were generated from, 0 if unknown
+//	24      8     length of the body in bytes
+//	32      4     CRC-32 (IEEE) of the body
+//	36      2     length n of the distribution name
+//	38      n     distribution name, UTF-8
+//
+// The raw body holds the elements as little-endian two's complement
+// integers of the header width. The delta body holds the difference
+// between each element and the previous one, the first one from 0, as a
+// zigzag varint: the ZigZag mapping of Protocol Buffers, 0, -1, 1, -2, ...
+// to 0, 1, 2, 3, ..., then 7 bits per byte from the lowest ones, with the
+// high bit set on every byte but the last. Sorted or clustered arrays take
+// one or two bytes per element. A reader rejects a file whose magic,
+// version, width, encoding or checksum it does not know or match.
+package dataset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+)
+
+// Version is the version of the file format written by Write.
+const Version = 1
+
+// magic starts every dataset file.
+const magic = "FUID"
+
+// headerLen is the length of the header before the distribution name.
+const headerLen = 38
+
+// ErrFormat is returned when a file is not a valid dataset file.
+var ErrFormat = errors.New("invalid dataset file")
+
+// ErrChecksum is returned when the body of a file does not match the
+// checksum of its header.
+var ErrChecksum = errors.New("dataset checksum mismatch")
+
+// Encoding is the encoding of the body of a file.
+type Encoding uint8
+
+const (
+	// Raw stores the elements as fixed-width integers.
+	Raw Encoding = iota
+	// Delta stores the differences between consecutive elements as zigzag
+	// varints.
+	Delta
+)
+
+var encodingNames = []string{"raw", "delta"}
+
+func (e Encoding) String() string {
+	if int(e) >= len(encodingNames) {
+		return fmt.Sprintf("Encoding(%d)", int(e))
+	}
+	return encodingNames[e]
+}
+
+// ParseEncoding returns the encoding named name, as printed by
+// Encoding.String.
+func ParseEncoding(name string) (Encoding, error) {
+	for i, encodingName := range encodingNames {
+		if name == encodingName {
+			return Encoding(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown encoding %q, want raw or delta", name)
+}
+
+// Dataset is an input array with the distribution and the seed it was
+// generated from.
+type Dataset struct {
+	Distribution string
+	Seed         uint64
+	Values       []int
+}
+
+// Header is the header of a dataset file.
+type Header struct {
+	Version      int
+	Width        int
+	Encoding     Encoding
+	Count        uint64
+	Seed         uint64
+	BodyLen      uint64
+	Checksum     uint32
+	Distribution string
+}
+
+// width returns the smallest width of the raw encoding holding values.
+func width(values []int) int {
+	for _, v := range values {
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return 8
+		}
+	}
+	return 4
+}
+
+// Write writes d in the given encoding. The elements are 4 bytes wide when
+// they are all in the int32 range, and 8 bytes wide otherwise.
+func Write(w io.Writer, d Dataset, encoding Encoding) error {
+	if len(d.Distribution) > math.MaxUint16 {
+		return fmt.Errorf("distribution name of %d bytes, the maximum is %d", len(d.Distribution), math.MaxUint16)
+	}
+	h := Header{
+		Version:      Version,
+		Width:        width(d.Values),
+		Encoding:     encoding,
+		Count:        uint64(len(d.Values)),
+		Seed:         d.Seed,
+		Distribution: d.Distribution,
+	}
+	var body []byte
+	switch encoding {
+	case Raw:
+		body = make([]byte, 0, len(d.Values)*h.Width)
+		for _, v := range d.Values {
+			if h.Width == 4 {
+				body = binary.LittleEndian.AppendUint32(body, uint32(int32(v)))
+			} else {
+				body = binary.LittleEndian.AppendUint64(body, uint64(v))
+			}
+		}
+	case Delta:
+		body = make([]byte, 0, len(d.Values)*2)
+		previous := 0
+		for _, v := range d.Values {
+			body = binary.AppendVarint(body, int64(v-previous))
+			previous = v
+		}
+	default:
+		return fmt.Errorf("unknown encoding %v", encoding)
+	}
+	h.BodyLen = uint64(len(body))
+	h.Checksum = crc32.ChecksumIEEE(body)
+
+	header := make([]byte, 0, headerLen+len(h.Distribution))
+	header = append(header, magic...)
+	header = binary.LittleEndian.AppendUint16(header, uint16(h.Version))
+	header = append(header, byte(h.Width), byte(h.Encoding))
+	header = binary.LittleEndian.AppendUint64(header, h.Count)
+	header = binary.LittleEndian.AppendUint64(header, h.Seed)
+	header = binary.LittleEndian.AppendUint64(header, h.BodyLen)
+	header = binary.LittleEndian.AppendUint32(header, h.Checksum)
+	header = binary.LittleEndian.AppendUint16(header, uint16(len(h.Distribution)))
+	header = append(header, h.Distribution...)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(body)
+	return err
+}
+
+// ReadHeader reads the header of a file, leaving r at the start of the
+// body.
+func ReadHeader(r io.Reader) (Header, error) {
+	buf := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return Header{}, fmt.Errorf("%w: truncated header", ErrFormat)
+		}
+		return Header{}, err
+	}
+	if string(buf[:4]) != magic {
+		return Header{}, fmt.Errorf("%w: bad magic %q", ErrFormat, buf[:4])
+	}
+	h := Header{
+		Version:  int(binary.LittleEndian.Uint16(buf[4:])),
+		Width:    int(buf[6]),
+		Encoding: Encoding(buf[7]),
+		Count:    binary.LittleEndian.Uint64(buf[8:]),
+		Seed:     binary.LittleEndian.Uint64(buf[16:]),
+		BodyLen:  binary.LittleEndian.Uint64(buf[24:]),
+		Checksum: binary.LittleEndian.Uint32(buf[32:]),
+	}
+	switch {
+	case h.Version != Version:
+		return Header{}, fmt.Errorf("%w: unsupported version %d, want %d", ErrFormat, h.Version, Version)
+	case h.Width != 4 && h.Width != 8:
+		return Header{}, fmt.Errorf("%w: element width %d, want 4 or 8", ErrFormat, h.Width)
+	case h.Encoding != Raw && h.Encoding != Delta:
+		return Header{}, fmt.Errorf("%w: unknown encoding %d", ErrFormat, h.Encoding)
+	case h.Encoding == Raw && (h.BodyLen%uint64(h.Width) != 0 || h.BodyLen/uint64(h.Width) != h.Count):
+		return Header{}, fmt.Errorf("%w: raw body of %d bytes for %d elements of %d bytes", ErrFormat, h.BodyLen, h.Count, h.Width)
+	case h.Encoding == Delta && (h.BodyLen < h.Count || h.BodyLen/binary.MaxVarintLen64 > h.Count):
+		return Header{}, fmt.Errorf("%w: delta body of %d bytes for %d elements", ErrFormat, h.BodyLen, h.Count)
+	}
+	name := make([]byte, binary.LittleEndian.Uint16(buf[36:]))
+	if _, err := io.ReadFull(r, name); err != nil {
+		return Header{}, fmt.Errorf("%w: truncated distribution name", ErrFormat)
+	}
+	h.Distribution = string(name)
+	return h, nil
+}
+
+// readBody reads the body of the file of header h and checks its checksum.
+// The buffer grows with the data read, so a corrupted length does not
+// allocate more than the file holds.
+func readBody(r io.Reader, h Header) ([]byte, error) {
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(io.LimitReader(r, int64(min(h.BodyLen, math.MaxInt64)))); err != nil {
+		return nil, err
+	}
+	if uint64(body.Len()) != h.BodyLen {
+		return nil, fmt.Errorf("%w: body of %d bytes, want %d", ErrFormat, body.Len(), h.BodyLen)
+	}
+	if sum := crc32.ChecksumIEEE(body.Bytes()); sum != h.Checksum {
+		return nil, fmt.Errorf("%w: body CRC-32 %08x, header %08x", ErrChecksum, sum, h.Checksum)
+	}
+	return body.Bytes(), nil
+}
+
+// Read reads a file written by Write, checking its checksum.
+func Read(r io.Reader) (Dataset, Header, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return Dataset{}, Header{}, err
+	}
+	body, err := readBody(r, h)
+	if err != nil {
+		return Dataset{}, Header{}, err
+	}
+	d := Dataset{Distribution: h.Distribution, Seed: h.Seed, Values: make([]int, h.Count)}
+	switch h.Encoding {
+	case Raw:
+		for i := range d.Values {
+			if h.Width == 4 {
+				d.Values[i] = int(int32(binary.LittleEndian.Uint32(body[4*i:])))
+			} else {
+				d.Values[i] = int(int64(binary.LittleEndian.Uint64(body[8*i:])))
+			}
+		}
+	case Delta:
+		previous := 0
+		for i := range d.Values {
+			delta, n := binary.Varint(body)
+			if n <= 0 {
+				return Dataset{}, Header{}, fmt.Errorf("%w: invalid varint of element %d", ErrFormat, i)
+			}
+			body = body[n:]
+			previous += int(delta)
+			if h.Width == 4 && (previous < math.MinInt32 || previous > math.MaxInt32) {
+				return Dataset{}, Header{}, fmt.Errorf("%w: element %d is %d, outside the int32 range of its width", ErrFormat, i, previous)
+			}
+			d.Values[i] = previous
+		}
+		if len(body) != 0 {
+			return Dataset{}, Header{}, fmt.Errorf("%w: %d bytes after the last element", ErrFormat, len(body))
+		}
+	}
+	return d, h, nil
+}
+
+// Verify reads the header of a file and checks the checksum of its body,
+// without decoding it.
+func Verify(r io.Reader) (Header, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return Header{}, err
+	}
+	_, err = readBody(r, h)
+	return h, err
+}
+
+// WriteFile writes d to the file at path in the given encoding.
+func WriteFile(path string, d Dataset, encoding Encoding) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = Write(file, d, encoding)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// ReadFile reads the file at path, checking its checksum.
+func ReadFile(path string) (Dataset, Header, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Dataset{}, Header{}, err
+	}
+	defer file.Close()
+	d, h, err := Read(file)
+	if err != nil {
+		return Dataset{}, Header{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, h, nil
+}
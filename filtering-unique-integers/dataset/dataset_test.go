@@ -0,0 +1,142 @@
+package dataset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, values := range [][]int{
+		{},
+		{0},
+		{16, 17, 2, 17, 4, 2, 97, 4, 17, 56},
+		{math.MinInt32, math.MaxInt32, 0, math.MinInt32},
+		{math.MinInt64, math.MaxInt64, -1, 1 << 40},
+	} {
+		for _, encoding := range []Encoding{Raw, Delta} {
+			var buf bytes.Buffer
+			in := Dataset{Distribution: "sample", Seed: 42, Values: values}
+			if err := Write(&buf, in, encoding); err != nil {
+				t.Fatal(err)
+			}
+			out, h, err := Read(&buf)
+			if err != nil {
+				t.Fatalf("%v %v: %v", encoding, values, err)
+			}
+			if !slices.Equal(out.Values, values) || out.Distribution != "sample" || out.Seed != 42 {
+				t.Errorf("%v: read %+v, want %v", encoding, out, values)
+			}
+			if h.Encoding != encoding || h.Count != uint64(len(values)) || h.Version != Version {
+				t.Errorf("%v: header %+v", encoding, h)
+			}
+			if wantWidth := width(values); h.Width != wantWidth {
+				t.Errorf("%v %v: width %d, want %d", encoding, values, h.Width, wantWidth)
+			}
+		}
+	}
+}
+
+// TestLayout checks the bytes of a file against the layout of the package
+// documentation, which the readers in other languages follow.
+func TestLayout(t *testing.T) {
+	d := Dataset{Distribution: "zipf", Seed: 7, Values: []int{3, -1}}
+	for _, test := range []struct {
+		encoding Encoding
+		body     []byte
+	}{
+		{Raw, []byte{3, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}},
+		// The deltas 3 and -4 are zigzagged to 6 and 7.
+		{Delta, []byte{6, 7}},
+	} {
+		want := []byte("FUID")
+		want = append(want, 1, 0, 4, byte(test.encoding))
+		want = append(want, 2, 0, 0, 0, 0, 0, 0, 0)
+		want = append(want, 7, 0, 0, 0, 0, 0, 0, 0)
+		want = append(want, byte(len(test.body)), 0, 0, 0, 0, 0, 0, 0)
+		want = binary.LittleEndian.AppendUint32(want, crc32.ChecksumIEEE(test.body))
+		want = append(want, 4, 0)
+		want = append(want, "zipf"...)
+		want = append(want, test.body...)
+
+		var buf bytes.Buffer
+		if err := Write(&buf, d, test.encoding); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf.Bytes(), want) {
+			t.Errorf("%v file:\n% x\nwant\n% x", test.encoding, buf.Bytes(), want)
+		}
+	}
+}
+
+func TestDeltaIsCompact(t *testing.T) {
+	d, err := Generate("sorted", 10000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw, delta bytes.Buffer
+	Write(&raw, d, Raw)
+	Write(&delta, d, Delta)
+	if delta.Len() >= raw.Len()/2 {
+		t.Errorf("delta encoding of a sorted array takes %d bytes, raw %d", delta.Len(), raw.Len())
+	}
+}
+
+func TestCorruption(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, Dataset{Distribution: "uniform", Seed: 1, Values: []int{1, 2, 3, 4}}, Raw); err != nil {
+		t.Fatal(err)
+	}
+	file := buf.Bytes()
+
+	corrupted := slices.Clone(file)
+	corrupted[len(corrupted)-1] ^= 1
+	if _, _, err := Read(bytes.NewReader(corrupted)); !errors.Is(err, ErrChecksum) {
+		t.Errorf("flipped body bit: error %v, want ErrChecksum", err)
+	}
+	if _, err := Verify(bytes.NewReader(corrupted)); !errors.Is(err, ErrChecksum) {
+		t.Errorf("Verify of a flipped body bit: error %v, want ErrChecksum", err)
+	}
+	if _, err := Verify(bytes.NewReader(file)); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+
+	for name, edit := range map[string]func([]byte) []byte{
+		"magic":    func(b []byte) []byte { b[0] = 'X'; return b },
+		"version":  func(b []byte) []byte { b[4] = 2; return b },
+		"width":    func(b []byte) []byte { b[6] = 3; return b },
+		"encoding": func(b []byte) []byte { b[7] = 9; return b },
+		"count":    func(b []byte) []byte { binary.LittleEndian.PutUint64(b[8:], 1<<40); return b },
+		"body": func(b []byte) []byte {
+			binary.LittleEndian.PutUint64(b[24:], 1<<40)
+			binary.LittleEndian.PutUint64(b[8:], 1<<38)
+			return b
+		},
+		"truncated": func(b []byte) []byte { return b[:len(b)-1] },
+		"header":    func(b []byte) []byte { return b[:10] },
+	} {
+		if _, _, err := Read(bytes.NewReader(edit(slices.Clone(file)))); !errors.Is(err, ErrFormat) {
+			t.Errorf("%s: error %v, want ErrFormat", name, err)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for _, distribution := range []string{"random", "zipf", "dup-0.9-fixed"} {
+		a, err := Generate(distribution, 1000, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := Generate(distribution, 1000, 7)
+		if len(a.Values) != 1000 || !slices.Equal(a.Values, b.Values) {
+			t.Errorf("%s: not reproducible from the same seed", distribution)
+		}
+	}
+	if _, err := Generate("unknown", 10, 1); err == nil {
+		t.Error("Generate of an unknown distribution succeeded")
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Author: Junior ADI
+Description: Generation of the datasets from a distribution and a seed
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package dataset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/uniqueints"
+)
+
+// Generate returns the dataset of size values of the named distribution
+// generated from seed, the same as the benchmark generates: "random" is
+// the legacy input of GenerateRandomInputArr, in (-10 size, 10 size), and
+// the other names are those accepted by generator.Lookup.
+func Generate(distribution string, size int, seed uint64) (Dataset, error) {
+	if size < 0 {
+		return Dataset{}, fmt.Errorf("negative size %d", size)
+	}
+	d := Dataset{Distribution: distribution, Seed: seed}
+	if distribution == "random" {
+		d.Values = make([]int, size)
+		if size > 0 {
+			if err := uniqueints.GenerateRandomInputArr(generator.NewRand(seed), d.Values, size, size*10); err != nil {
+				return Dataset{}, err
+			}
+		}
+		return d, nil
+	}
+	dist, ok := generator.Lookup(distribution)
+	if !ok {
+		return Dataset{}, fmt.Errorf("unknown distribution %q, known distributions: random, %s, or dup-<ratio>-<profile>", distribution, strings.Join(generator.Names(), ", "))
+	}
+	d.Values = dist.Generate(generator.NewRand(seed), size)
+	return d, nil
+}
@@ -0,0 +1,287 @@
+/*
+Author: Junior ADI
+Description: Arrays with an exact number of distinct values
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package generator
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Profile is the distribution of the multiplicities of the distinct values
+// of an array, the number of times each of them appears.
+type Profile int
+
+const (
+	// Fixed gives every distinct value the same multiplicity, within one.
+	Fixed Profile = iota
+	// Geometric draws the multiplicities from a geometric law: small
+	// multiplicities are the most frequent, and larger ones get
+	// exponentially rarer.
+	Geometric
+	// PowerLaw draws the multiplicities from a Pareto law of exponent
+	// Alpha: most values appear only a few times, and a few of them make a
+	// large part of the array.
+	PowerLaw
+)
+
+var profileNames = []string{"fixed", "geometric", "power-law"}
+
+func (p Profile) String() string {
+	if p < 0 || int(p) >= len(profileNames) {
+		return fmt.Sprintf("Profile(%d)", int(p))
+	}
+	return profileNames[p]
+}
+
+// ParseProfile returns the profile named name, as printed by
+// Profile.String.
+func ParseProfile(name string) (Profile, error) {
+	for i, profileName := range profileNames {
+		if name == profileName {
+			return Profile(i), nil
+		}
+	}
+	return 0, &ParamError{Param: "profile", Value: name, Reason: "want fixed, geometric or power-law"}
+}
+
+// ParamError reports an invalid parameter of the generated arrays.
+type ParamError struct {
+	Param  string
+	Value  any
+	Reason string
+}
+
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("invalid %s %v: %s", e.Param, e.Value, e.Reason)
+}
+
+// DefaultAlpha is the exponent of the PowerLaw profile when Cardinality.Alpha
+// is 0.
+const DefaultAlpha = 1.5
+
+// Cardinality describes the arrays of Size values with exactly Distinct
+// distinct values, whose multiplicities follow Profile.
+type Cardinality struct {
+	Size     int
+	Distinct int
+	Profile  Profile
+	// Alpha is the exponent of the Pareto law of the PowerLaw profile: a
+	// value appears more than k times with a probability falling as
+	// k^-Alpha. It must be above 1, and is DefaultAlpha if 0.
+	Alpha float64
+}
+
+// alpha returns the exponent of the PowerLaw profile.
+func (c Cardinality) alpha() float64 {
+	if c.Alpha == 0 {
+		return DefaultAlpha
+	}
+	return c.Alpha
+}
+
+// Validate returns a *ParamError if the array described by c cannot be
+// generated.
+func (c Cardinality) Validate() error {
+	switch {
+	case c.Size < 0:
+		return &ParamError{Param: "size", Value: c.Size, Reason: "negative"}
+	case c.Size > 0 && c.Distinct < 1:
+		return &ParamError{Param: "distinct count", Value: c.Distinct, Reason: "a non-empty array has at least 1 distinct value"}
+	case c.Size == 0 && c.Distinct != 0:
+		return &ParamError{Param: "distinct count", Value: c.Distinct, Reason: "an empty array has no distinct value"}
+	case c.Distinct > c.Size:
+		return &ParamError{Param: "distinct count", Value: c.Distinct, Reason: fmt.Sprintf("more than the size %d", c.Size)}
+	case c.Distinct > math.MaxInt32:
+		return &ParamError{Param: "distinct count", Value: c.Distinct, Reason: "more than the int32 range can hold"}
+	case c.Profile < Fixed || c.Profile > PowerLaw:
+		return &ParamError{Param: "profile", Value: c.Profile, Reason: "want fixed, geometric or power-law"}
+	case c.Profile == PowerLaw && (!(c.alpha() > 1) || math.IsInf(c.alpha(), 0)):
+		return &ParamError{Param: "alpha", Value: c.Alpha, Reason: "the exponent must be a finite number above 1"}
+	}
+	return nil
+}
+
+// Generate returns a shuffled array of c.Size values drawn with r, with
+// exactly c.Distinct distinct values in the int32 range.
+func (c Cardinality) Generate(r *rand.Rand) ([]int, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	out := make([]int, c.Size)
+	c.fill(r, out)
+	return out, nil
+}
+
+// fill fills out, of c.Size values, as Generate. c must be valid.
+func (c Cardinality) fill(r *rand.Rand, out []int) {
+	values := distinctValues(r, c.Distinct, span(c.Size))
+	i := 0
+	for j, count := range c.multiplicities(r) {
+		for k := 0; k < count; k++ {
+			out[i] = values[j]
+			i++
+		}
+	}
+	r.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+}
+
+// distinctValues returns n distinct values drawn uniformly from [-s, s],
+// which holds at least n values.
+func distinctValues(r *rand.Rand, n, s int) []int {
+	values := make([]int, 0, n)
+	seen := make(map[int]bool, n)
+	for len(values) < n {
+		v := r.IntN(2*s+1) - s
+		if !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// multiplicities returns the number of copies of each of the c.Distinct
+// values, summing to c.Size. Each value gets one copy, and the c.Size -
+// c.Distinct extra copies are shared in proportion to multiplicities drawn
+// from the profile, minus one, by the largest remainder method: the shape
+// of the profile is kept while the counts are exact.
+func (c Cardinality) multiplicities(r *rand.Rand) []int {
+	counts := make([]int, c.Distinct)
+	if c.Distinct == 0 {
+		return counts
+	}
+	mean := float64(c.Size) / float64(c.Distinct)
+	weights := make([]float64, c.Distinct)
+	var total float64
+	for i := range weights {
+		u := 1 - r.Float64() // in (0, 1]
+		switch c.Profile {
+		case Fixed:
+			weights[i] = 1
+		case Geometric:
+			// The number of failures before the first success of
+			// probability 1/mean, whose mean is the mean number of extra
+			// copies, mean - 1.
+			if mean > 1 {
+				weights[i] = math.Floor(math.Log(u) / math.Log1p(-1/mean))
+			}
+		case PowerLaw:
+			weights[i] = math.Pow(u, -1/c.alpha()) - 1
+		}
+		total += weights[i]
+	}
+	if total == 0 {
+		for i := range weights {
+			weights[i] = 1
+		}
+		total = float64(len(weights))
+	}
+
+	extra := c.Size - c.Distinct
+	shared := 0
+	remainders := make([]float64, len(counts))
+	for i, w := range weights {
+		quota := float64(extra) * w / total
+		counts[i] = int(quota)
+		remainders[i] = quota - float64(counts[i])
+		shared += counts[i]
+	}
+	// Give the copies left by the rounding to the largest remainders, or
+	// take the ones rounded up too many from the smallest.
+	order := make([]int, len(counts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for i := 0; shared < extra; i = (i + 1) % len(order) {
+		counts[order[i]]++
+		shared++
+	}
+	for i := len(order) - 1; shared > extra; i = (i - 1 + len(order)) % len(order) {
+		if counts[order[i]] > 0 {
+			counts[order[i]]--
+			shared--
+		}
+	}
+	for i := range counts {
+		counts[i]++
+	}
+	return counts
+}
+
+// ForDuplicateRatio returns the Cardinality of the arrays of size values of
+// which the fraction ratio are duplicates of another value: the distinct
+// count is size × (1 - ratio), rounded, and at least 1 for a non-empty
+// array.
+func ForDuplicateRatio(size int, ratio float64, profile Profile) (Cardinality, error) {
+	if !(ratio >= 0 && ratio <= 1) {
+		return Cardinality{}, &ParamError{Param: "duplicate ratio", Value: ratio, Reason: "want a fraction between 0 and 1"}
+	}
+	c := Cardinality{Size: size, Profile: profile}
+	if size > 0 {
+		c.Distinct = max(int(math.Round(float64(size)*(1-ratio))), 1)
+	}
+	return c, c.Validate()
+}
+
+// duplicatePrefix starts the names of the distributions of a duplicate
+// ratio.
+const duplicatePrefix = "dup-"
+
+// DuplicateName returns the name of the distribution of the arrays with the
+// given duplicate ratio and multiplicity profile, such as
+// "dup-0.9-geometric". Lookup accepts these names.
+func DuplicateName(ratio float64, profile Profile) string {
+	return duplicatePrefix + strconv.FormatFloat(ratio, 'g', -1, 64) + "-" + profile.String()
+}
+
+// ParseDuplicateName returns the duplicate ratio and profile of a name
+// returned by DuplicateName.
+func ParseDuplicateName(name string) (float64, Profile, error) {
+	rest, ok := strings.CutPrefix(name, duplicatePrefix)
+	ratioField, profileField, found := strings.Cut(rest, "-")
+	if !ok || !found {
+		return 0, 0, &ParamError{Param: "distribution", Value: name, Reason: "want dup-<ratio>-<profile>"}
+	}
+	ratio, err := strconv.ParseFloat(ratioField, 64)
+	if err != nil || !(ratio >= 0 && ratio <= 1) {
+		return 0, 0, &ParamError{Param: "duplicate ratio", Value: ratioField, Reason: "want a fraction between 0 and 1"}
+	}
+	profile, err := ParseProfile(profileField)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ratio, profile, nil
+}
+
+// duplicateDistribution returns the distribution named name by
+// DuplicateName.
+func duplicateDistribution(name string) (Distribution, bool) {
+	ratio, profile, err := ParseDuplicateName(name)
+	if err != nil {
+		return Distribution{}, false
+	}
+	return Distribution{
+		Name:        name,
+		Description: fmt.Sprintf("%g of duplicates with %s multiplicities", ratio, profile),
+		fill: func(r *rand.Rand, out []int) {
+			c, _ := ForDuplicateRatio(len(out), ratio, profile)
+			c.fill(r, out)
+		},
+	}, true
+}
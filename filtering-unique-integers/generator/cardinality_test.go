@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+// counts returns the multiplicity of every value of arr.
+func counts(arr []int) map[int]int {
+	m := make(map[int]int)
+	for _, v := range arr {
+		m[v]++
+	}
+	return m
+}
+
+func TestCardinalityExact(t *testing.T) {
+	for _, profile := range []Profile{Fixed, Geometric, PowerLaw} {
+		for _, c := range []Cardinality{
+			{Size: 0, Distinct: 0},
+			{Size: 1, Distinct: 1},
+			{Size: 10, Distinct: 1},
+			{Size: 10, Distinct: 10},
+			{Size: 1000, Distinct: 7},
+			{Size: 10000, Distinct: 9000},
+			{Size: 10000, Distinct: 100},
+		} {
+			c.Profile = profile
+			arr, err := c.Generate(NewRand(1))
+			if err != nil {
+				t.Fatalf("%+v: %v", c, err)
+			}
+			if len(arr) != c.Size {
+				t.Errorf("%+v: %d values", c, len(arr))
+			}
+			if n := distinct(arr); n != c.Distinct {
+				t.Errorf("%+v: %d distinct values", c, n)
+			}
+			for _, v := range arr {
+				if v < math.MinInt32 || v > math.MaxInt32 {
+					t.Fatalf("%+v: value %d outside the int32 range", c, v)
+				}
+			}
+			again, _ := c.Generate(NewRand(1))
+			if !slices.Equal(arr, again) {
+				t.Errorf("%+v: not reproducible from the same seed", c)
+			}
+		}
+	}
+}
+
+func TestCardinalityProfiles(t *testing.T) {
+	maxCount := func(profile Profile) (lowest, highest int) {
+		arr, err := Cardinality{Size: 100000, Distinct: 10000, Profile: profile}.Generate(NewRand(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		lowest = math.MaxInt
+		for _, n := range counts(arr) {
+			lowest, highest = min(lowest, n), max(highest, n)
+		}
+		return lowest, highest
+	}
+	if lowest, highest := maxCount(Fixed); lowest != 10 || highest != 10 {
+		t.Errorf("fixed multiplicities from %d to %d, want 10", lowest, highest)
+	}
+	_, geometric := maxCount(Geometric)
+	_, powerLaw := maxCount(PowerLaw)
+	if geometric <= 10 || geometric > 200 {
+		t.Errorf("geometric multiplicities up to %d", geometric)
+	}
+	// The heavy tail of the power law gives much larger multiplicities.
+	if powerLaw <= 2*geometric {
+		t.Errorf("power-law multiplicities up to %d, geometric up to %d", powerLaw, geometric)
+	}
+}
+
+func TestCardinalityValidate(t *testing.T) {
+	for _, c := range []Cardinality{
+		{Size: -1},
+		{Size: 10, Distinct: 0},
+		{Size: 10, Distinct: 11},
+		{Size: 0, Distinct: 1},
+		{Size: 10, Distinct: 5, Profile: Profile(7)},
+		{Size: 10, Distinct: 5, Profile: PowerLaw, Alpha: 1},
+		{Size: 10, Distinct: 5, Profile: PowerLaw, Alpha: math.NaN()},
+		{Size: 10, Distinct: 5, Profile: PowerLaw, Alpha: math.Inf(1)},
+	} {
+		_, err := c.Generate(NewRand(1))
+		var paramErr *ParamError
+		if !errors.As(err, &paramErr) {
+			t.Errorf("%+v: error %v, want a *ParamError", c, err)
+		}
+	}
+	if _, err := ForDuplicateRatio(10, 1.5, Fixed); err == nil {
+		t.Error("ForDuplicateRatio accepted a ratio of 1.5")
+	}
+}
+
+func TestForDuplicateRatio(t *testing.T) {
+	for _, test := range []struct {
+		size     int
+		ratio    float64
+		distinct int
+	}{
+		{1000, 0, 1000},
+		{1000, 0.9, 100},
+		{1000, 1, 1},
+		{1, 0.5, 1},
+		{0, 0.5, 0},
+	} {
+		c, err := ForDuplicateRatio(test.size, test.ratio, Geometric)
+		if err != nil || c.Distinct != test.distinct {
+			t.Errorf("ForDuplicateRatio(%d, %g) = %+v, %v, want %d distinct values", test.size, test.ratio, c, err, test.distinct)
+		}
+	}
+}
+
+func TestDuplicateName(t *testing.T) {
+	name := DuplicateName(0.9, PowerLaw)
+	if name != "dup-0.9-power-law" {
+		t.Errorf("DuplicateName = %q", name)
+	}
+	ratio, profile, err := ParseDuplicateName(name)
+	if err != nil || ratio != 0.9 || profile != PowerLaw {
+		t.Errorf("ParseDuplicateName(%q) = %g, %v, %v", name, ratio, profile, err)
+	}
+	d, ok := Lookup(name)
+	if !ok || d.Name != name {
+		t.Fatalf("Lookup(%q) = %v, %t", name, d.Name, ok)
+	}
+	if n := distinct(d.Generate(NewRand(3), 1000)); n != 100 {
+		t.Errorf("%s has %d distinct values out of 1000, want 100", name, n)
+	}
+	for _, bad := range []string{"dup-", "dup-0.9", "dup-2-fixed", "dup-x-fixed", "dup-0.5-linear", "uniform-0.5-fixed"} {
+		if _, ok := Lookup(bad); ok {
+			t.Errorf("Lookup(%q) succeeded", bad)
+		}
+	}
+}
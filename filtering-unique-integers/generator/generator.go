@@ -0,0 +1,183 @@
+/*
+Author: Junior ADI
+Description: Named input distributions of the unique integers filters
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package generator generates the input arrays of the filters from named
+// distributions, from realistic ones such as Zipf to adversarial ones such
+// as the multiples of 65536, which make the block-based filters allocate a
+// new block for every distinct value.
+//
+// Every value is in the int32 range, so that every filter can be run on
+// every distribution.
+package generator
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// Distribution is a named distribution of input arrays.
+type Distribution struct {
+	Name        string
+	Description string
+	fill        func(r *rand.Rand, out []int)
+}
+
+// Generate returns an array of size values drawn from the distribution
+// with r.
+func (d Distribution) Generate(r *rand.Rand, size int) []int {
+	out := make([]int, size)
+	d.fill(r, out)
+	return out
+}
+
+// span returns the half-width of the range of the uniform values of an
+// array of n values: 10 times n, like GenerateRandomInputArr, within the
+// int32 range.
+func span(n int) int {
+	return min(10*max(n, 1), math.MaxInt32)
+}
+
+// clampInt32 returns v clamped to the int32 range.
+func clampInt32(v float64) int {
+	return int(math.Max(math.MinInt32, math.Min(math.MaxInt32, math.Round(v))))
+}
+
+func fillUniform(r *rand.Rand, out []int) {
+	s := span(len(out))
+	for i := range out {
+		out[i] = r.IntN(2*s+1) - s
+	}
+}
+
+// fillZipf draws the ranks, from 0 to 10n, of a Zipf law of exponent 1.1, so
+// that a few values make most of the array, like the words of a text.
+func fillZipf(r *rand.Rand, out []int) {
+	zipf := rand.NewZipf(r, 1.1, 1, uint64(span(len(out))))
+	for i := range out {
+		out[i] = int(zipf.Uint64())
+	}
+}
+
+// fillNormal draws a normal law centered on 0 with a standard deviation of
+// n, so that about 40% of the values are distinct.
+func fillNormal(r *rand.Rand, out []int) {
+	sigma := float64(max(len(out), 1))
+	for i := range out {
+		out[i] = clampInt32(r.NormFloat64() * sigma)
+	}
+}
+
+func fillSorted(r *rand.Rand, out []int) {
+	fillUniform(r, out)
+	slices.Sort(out)
+}
+
+func fillReverseSorted(r *rand.Rand, out []int) {
+	fillSorted(r, out)
+	slices.Reverse(out)
+}
+
+func fillAllEqual(r *rand.Rand, out []int) {
+	value := int(r.Int32())
+	for i := range out {
+		out[i] = value
+	}
+}
+
+// fillAllUnique shuffles n consecutive values centered on 0.
+func fillAllUnique(r *rand.Rand, out []int) {
+	for i := range out {
+		out[i] = i - len(out)/2
+	}
+	r.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+}
+
+// clusterWidth is the width of the clusters of the clustered distribution.
+const clusterWidth = 1024
+
+// fillClustered draws the values around one center per 1000 values, spread
+// over the whole int32 range, each value within clusterWidth of its center.
+func fillClustered(r *rand.Rand, out []int) {
+	centers := make([]int, max(len(out)/1000, 1))
+	for i := range centers {
+		centers[i] = int(r.Int32N(math.MaxInt32 - clusterWidth))
+		if r.IntN(2) == 0 {
+			centers[i] = -centers[i]
+		}
+	}
+	for i := range out {
+		out[i] = centers[r.IntN(len(centers))] + r.IntN(clusterWidth)
+	}
+}
+
+// sawtoothPeriod is the length of the ramps of the sawtooth distribution.
+const sawtoothPeriod = 1000
+
+// fillSawtooth repeats the ramp from 0 to sawtoothPeriod - 1, starting at a
+// random phase, like GenerateGrowingArrImproved.
+func fillSawtooth(r *rand.Rand, out []int) {
+	phase := r.IntN(sawtoothPeriod)
+	for i := range out {
+		out[i] = (i + phase) % sawtoothPeriod
+	}
+}
+
+// fillMultiples65536 draws multiples of 65536. The block-based filters,
+// the hash table and bitmap ports of the C filters and BitHashTable, select
+// a block by the quotient of a value by 65536, so every distinct value
+// lands at offset 0 of a block of its own: each one allocates a new block,
+// up to 512 KiB for HT, of which a single flag is used.
+func fillMultiples65536(r *rand.Rand, out []int) {
+	for i := range out {
+		out[i] = (r.IntN(65536) - 32768) * 65536
+	}
+}
+
+var distributions = []Distribution{
+	{"uniform", "uniform values in [-10n, 10n] like GenerateRandomInputArr", fillUniform},
+	{"zipf", "Zipf law of exponent 1.1 over [0, 10n]", fillZipf},
+	{"normal", "normal law of mean 0 and standard deviation n", fillNormal},
+	{"sorted", "uniform values in increasing order", fillSorted},
+	{"reverse-sorted", "uniform values in decreasing order", fillReverseSorted},
+	{"all-equal", "a single value repeated", fillAllEqual},
+	{"all-unique", "a shuffled range of n distinct values", fillAllUnique},
+	{"clustered", "values within 1024 of one of n/1000 centers spread over the int32 range", fillClustered},
+	{"sawtooth", "ramps from 0 to 999 at a random phase", fillSawtooth},
+	{"multiples-65536", "multiples of 65536, each distinct value in a new 65536-wide block", fillMultiples65536},
+}
+
+// Distributions returns every distribution.
+func Distributions() []Distribution {
+	return slices.Clone(distributions)
+}
+
+// Names returns the names of every distribution.
+func Names() []string {
+	names := make([]string, len(distributions))
+	for i, d := range distributions {
+		names[i] = d.Name
+	}
+	return names
+}
+
+// Lookup returns the distribution with the given name: one of Names, or a
+// duplicate ratio and multiplicity profile named by DuplicateName.
+func Lookup(name string) (Distribution, bool) {
+	for _, d := range distributions {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return duplicateDistribution(name)
+}
@@ -0,0 +1,103 @@
+package generator
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// distinct returns the number of distinct values of arr.
+func distinct(arr []int) int {
+	seen := make(map[int]bool)
+	for _, v := range arr {
+		seen[v] = true
+	}
+	return len(seen)
+}
+
+func TestDistributions(t *testing.T) {
+	const size = 10000
+	for _, d := range Distributions() {
+		if got, ok := Lookup(d.Name); !ok || got.Name != d.Name {
+			t.Errorf("Lookup(%q) = %v, %t", d.Name, got.Name, ok)
+		}
+		for _, n := range []int{0, 1, 2, size} {
+			arr := d.Generate(rand.New(rand.NewPCG(1, 2)), n)
+			if len(arr) != n {
+				t.Fatalf("%s: %d values, want %d", d.Name, len(arr), n)
+			}
+			for _, v := range arr {
+				if v < math.MinInt32 || v > math.MaxInt32 {
+					t.Fatalf("%s: value %d outside the int32 range", d.Name, v)
+				}
+			}
+		}
+		a := d.Generate(rand.New(rand.NewPCG(1, 2)), size)
+		b := d.Generate(rand.New(rand.NewPCG(1, 2)), size)
+		if !slices.Equal(a, b) {
+			t.Errorf("%s: not reproducible from the same source", d.Name)
+		}
+	}
+	if _, ok := Lookup("unknown"); ok {
+		t.Error("Lookup of an unknown distribution succeeded")
+	}
+}
+
+func TestDistributionShapes(t *testing.T) {
+	const size = 10000
+	gen := func(name string) []int {
+		d, _ := Lookup(name)
+		return d.Generate(rand.New(rand.NewPCG(3, 4)), size)
+	}
+	if arr := gen("sorted"); !slices.IsSorted(arr) {
+		t.Error("sorted is not sorted")
+	}
+	if arr := gen("reverse-sorted"); !slices.IsSortedFunc(arr, func(a, b int) int { return b - a }) {
+		t.Error("reverse-sorted is not sorted in decreasing order")
+	}
+	if n := distinct(gen("all-equal")); n != 1 {
+		t.Errorf("all-equal has %d distinct values", n)
+	}
+	if n := distinct(gen("all-unique")); n != size {
+		t.Errorf("all-unique has %d distinct values, want %d", n, size)
+	}
+	if n := distinct(gen("sawtooth")); n != sawtoothPeriod {
+		t.Errorf("sawtooth has %d distinct values, want %d", n, sawtoothPeriod)
+	}
+	for _, v := range gen("multiples-65536") {
+		if v%65536 != 0 {
+			t.Fatalf("multiples-65536 has %d", v)
+		}
+	}
+	// A few values make most of a Zipf array, the most frequent being 0.
+	zipf := gen("zipf")
+	if n := distinct(zipf); n > size/2 {
+		t.Errorf("zipf has %d distinct values out of %d", n, size)
+	}
+	if slices.Min(zipf) != 0 || slices.Max(zipf) > 10*size {
+		t.Errorf("zipf ranges over [%d, %d], want [0, %d] at most", slices.Min(zipf), slices.Max(zipf), 10*size)
+	}
+	if n := distinct(gen("uniform")); n < size*9/10 {
+		t.Errorf("uniform has only %d distinct values out of %d", n, size)
+	}
+}
+
+func TestNewRand(t *testing.T) {
+	for _, d := range Distributions() {
+		a := d.Generate(NewRand(42), 1000)
+		b := d.Generate(NewRand(42), 1000)
+		c := d.Generate(NewRand(43), 1000)
+		if !slices.Equal(a, b) {
+			t.Errorf("%s: seed 42 generated two different arrays", d.Name)
+		}
+		if slices.Equal(a, c) {
+			t.Errorf("%s: seeds 42 and 43 generated the same array", d.Name)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		if NewSeed(nil) == 0 {
+			t.Fatal("NewSeed returned 0")
+		}
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Author: Junior ADI
+Description: Seeded random sources of the input arrays
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package generator
+
+import "math/rand/v2"
+
+// seedStream is the second word of the PCG state of NewRand. It is fixed so
+// that a single 64-bit seed, as recorded in the benchmark results, names a
+// source.
+const seedStream = 0x9e3779b97f4a7c15
+
+// NewRand returns the random source of seed. Two sources of the same seed
+// draw the same values, so an array generated from a recorded seed can be
+// generated again.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seedStream))
+}
+
+// NewSeed returns a random seed drawn from r, or from the global source if
+// r is nil. It is never 0, which the benchmark results use for inputs
+// without a seed.
+func NewSeed(r *rand.Rand) uint64 {
+	for {
+		var seed uint64
+		if r == nil {
+			seed = rand.Uint64()
+		} else {
+			seed = r.Uint64()
+		}
+		if seed != 0 {
+			return seed
+		}
+	}
+}
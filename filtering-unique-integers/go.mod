@@ -0,0 +1,3 @@
+module github.com/junior-adi/Algorithmic/filtering-unique-integers
+
+go 1.22
@@ -0,0 +1,236 @@
+/*
+Author: Junior ADI
+Description: Self-contained HTML report of the benchmark results
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package report writes the benchmark results as a single HTML file that
+// needs neither network access nor scripts: the styles and the SVG charts
+// are embedded in the page.
+package report
+
+import (
+	"bytes"
+	"html/template"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/chart"
+)
+
+// winner is the fastest correct algorithm for one size and distribution.
+type winner struct {
+	Distribution string
+	Size         int
+	Algorithm    string
+	Ns           int64
+	// RunnerUp is the second fastest correct algorithm, empty if there is
+	// none, and Ratio is its median time divided by the winner's.
+	RunnerUp string
+	Ratio    float64
+}
+
+// winners returns the winner of every size and distribution of records,
+// sorted by distribution and size. An algorithm measured several times at
+// the same size is ranked by its fastest median, and neither an algorithm
+// whose output was incorrect nor a projected or skipped record ever wins.
+func winners(records []bench.Record) []winner {
+	type key struct {
+		distribution string
+		size         int
+	}
+	best := make(map[key]map[string]int64)
+	for _, record := range records {
+		if record.Failure != "" || record.Projected || record.Skipped != "" {
+			continue
+		}
+		k := key{record.Distribution, record.Size}
+		if best[k] == nil {
+			best[k] = make(map[string]int64)
+		}
+		if ns, ok := best[k][record.Algorithm]; !ok || record.Ns < ns {
+			best[k][record.Algorithm] = record.Ns
+		}
+	}
+
+	var result []winner
+	for k, times := range best {
+		algorithms := make([]string, 0, len(times))
+		for algorithm := range times {
+			algorithms = append(algorithms, algorithm)
+		}
+		sort.Slice(algorithms, func(i, j int) bool {
+			if times[algorithms[i]] != times[algorithms[j]] {
+				return times[algorithms[i]] < times[algorithms[j]]
+			}
+			return algorithms[i] < algorithms[j]
+		})
+		w := winner{Distribution: k.distribution, Size: k.size, Algorithm: algorithms[0], Ns: times[algorithms[0]]}
+		if len(algorithms) > 1 {
+			w.RunnerUp = algorithms[1]
+			if w.Ns > 0 {
+				w.Ratio = float64(times[algorithms[1]]) / float64(w.Ns)
+			}
+		}
+		result = append(result, w)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Distribution != result[j].Distribution {
+			return result[i].Distribution < result[j].Distribution
+		}
+		return result[i].Size < result[j].Size
+	})
+	return result
+}
+
+// environments returns the distinct environments of records, ignoring the
+// timestamps, in the order they first appear.
+func environments(records []bench.Record) []bench.Environment {
+	var envs []bench.Environment
+	seen := make(map[bench.Environment]bool)
+	for _, record := range records {
+		env := record.Environment
+		env.Timestamp = time.Time{}
+		if !seen[env] {
+			seen[env] = true
+			envs = append(envs, record.Environment)
+		}
+	}
+	return envs
+}
+
+// section holds the charts and the summary table of one distribution.
+type section struct {
+	Distribution string
+	Charts       []template.HTML
+	Records      []bench.Record
+	// Projected tells whether some of the records are projected.
+	Projected bool
+}
+
+// sections returns the section of every distribution of records.
+func sections(records []bench.Record) ([]section, error) {
+	var result []section
+	for _, distribution := range chart.Distributions(records) {
+		s := section{Distribution: distribution}
+		for _, kind := range chart.Kinds {
+			var buf bytes.Buffer
+			if err := chart.FromRecords(records, distribution, kind).WriteSVG(&buf); err != nil {
+				return nil, err
+			}
+			// The SVG is generated by the chart package, which escapes
+			// every text it writes.
+			s.Charts = append(s.Charts, template.HTML(buf.String()))
+		}
+		for _, record := range records {
+			if record.Distribution == distribution {
+				s.Records = append(s.Records, record)
+				s.Projected = s.Projected || record.Projected
+			}
+		}
+		sort.SliceStable(s.Records, func(i, j int) bool {
+			if s.Records[i].Size != s.Records[j].Size {
+				return s.Records[i].Size < s.Records[j].Size
+			}
+			return s.Records[i].Ns < s.Records[j].Ns
+		})
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+var page = template.Must(template.New("report").Funcs(template.FuncMap{
+	"ns":    func(ns int64) string { return bench.FormatNs(float64(ns)) },
+	"bytes": func(n uint64) string { return chart.FormatBytes(float64(n)) },
+	"time":  func(t time.Time) string { return t.Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: right; }
+th { background: #f0f0f0; }
+td.name { text-align: left; }
+tr.failure { background: #fde0e0; }
+tr.projected { color: #888; font-style: italic; }
+tr.skipped { color: #888; }
+.charts svg { max-width: 100%; height: auto; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Generated on {{time .Generated}} from {{len .Records}} measurements.</p>
+
+<h2>Environment</h2>
+<table>
+<tr><th>Go</th><th>OS/Arch</th><th>GOMAXPROCS</th><th>CPU</th><th>Run at</th></tr>
+{{range .Environments}}<tr><td class="name">{{.GoVersion}}</td><td class="name">{{.GOOS}}/{{.GOARCH}}</td><td>{{.GOMAXPROCS}}</td><td class="name">{{.CPUModel}}</td><td class="name">{{time .Timestamp}}</td></tr>
+{{end}}</table>
+
+<h2>Winners</h2>
+<table>
+<tr><th>Distribution</th><th>Size</th><th>Fastest</th><th>Median</th><th>Runner-up</th><th>Runner-up slower by</th></tr>
+{{range .Winners}}<tr><td class="name">{{.Distribution}}</td><td>{{.Size}}</td><td class="name">{{.Algorithm}}</td><td>{{ns .Ns}}</td><td class="name">{{.RunnerUp}}</td><td>{{if .RunnerUp}}{{printf "%.2fx" .Ratio}}{{end}}</td></tr>
+{{end}}</table>
+
+<h2>Correctness</h2>
+{{if .Failures}}<p>The output of these algorithms differed from the reference filter. They are left out of the winners.</p>
+<table>
+<tr><th>Algorithm</th><th>Distribution</th><th>Size</th><th>Failure</th></tr>
+{{range .Failures}}<tr class="failure"><td class="name">{{.Algorithm}}</td><td class="name">{{.Distribution}}</td><td>{{.Size}}</td><td class="name">{{.Failure}}</td></tr>
+{{end}}</table>
+{{else}}<p>Every algorithm returned the same values as the reference filter.</p>
+{{end}}
+{{range .Sections}}
+<h2>Distribution {{.Distribution}}</h2>
+<div class="charts">
+{{range .Charts}}{{.}}
+{{end}}</div>
+{{if .Projected}}<p>The projected times were not measured: the algorithm exceeded the time budget at a smaller size, and its time is extrapolated from its growth curve.</p>
+{{end}}<table>
+<tr><th>Size</th><th>Algorithm</th><th>Median</th><th>Mean</th><th>95% CI</th><th>P95</th><th>Runs</th><th>Rejected</th><th>Allocs</th><th>Bytes</th></tr>
+{{range .Records}}{{if .Skipped}}<tr class="skipped"><td>{{.Size}}</td><td class="name">{{.Algorithm}} (skipped)</td><td class="name" colspan="8">{{.Skipped}}</td></tr>
+{{else}}<tr{{if .Failure}} class="failure"{{else if .Projected}} class="projected"{{end}}><td>{{.Size}}</td><td class="name">{{.Algorithm}}{{if .Projected}} (projected){{end}}</td><td>{{ns .Ns}}</td><td>{{ns .MeanNs}}</td><td>{{ns .CILowNs}} – {{ns .CIHighNs}}</td><td>{{ns .P95Ns}}</td><td>{{.Runs}}</td><td>{{.Rejected}}</td><td>{{.Allocs}}</td><td>{{bytes .Bytes}}</td></tr>
+{{end}}{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
+
+// Write writes the HTML report of records, titled title. The report holds
+// the environments of the runs, the fastest algorithm of every size and
+// distribution, the correctness failures, and per distribution the charts
+// and a summary table of every measurement.
+func Write(w io.Writer, title string, records []bench.Record) error {
+	secs, err := sections(records)
+	if err != nil {
+		return err
+	}
+	var failures []bench.Record
+	for _, record := range records {
+		if record.Failure != "" {
+			failures = append(failures, record)
+		}
+	}
+	return page.Execute(w, struct {
+		Title        string
+		Generated    time.Time
+		Records      []bench.Record
+		Environments []bench.Environment
+		Winners      []winner
+		Failures     []bench.Record
+		Sections     []section
+	}{title, time.Now(), records, environments(records), winners(records), failures, secs})
+}
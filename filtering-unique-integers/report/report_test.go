@@ -0,0 +1,61 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/bench"
+)
+
+func testRecords() []bench.Record {
+	env := bench.Environment{GoVersion: "go1.22.0", GOOS: "linux", GOARCH: "amd64", GOMAXPROCS: 4, CPUModel: "Test CPU", Timestamp: time.Unix(0, 0)}
+	return []bench.Record{
+		{Algorithm: "Naive", Size: 100, Distribution: "random", Ns: 4000, MeanNs: 4000, Environment: env},
+		{Algorithm: "HashTable", Size: 100, Distribution: "random", Ns: 2000, MeanNs: 2000, Environment: env},
+		{Algorithm: "Broken", Size: 100, Distribution: "random", Ns: 10, MeanNs: 10, Failure: "got 3 unique values, want 4", Environment: env},
+		{Algorithm: "HashTable", Size: 1000, Distribution: "random", Ns: 20000, MeanNs: 20000, Environment: env},
+		{Algorithm: "HT", Size: 1000, Distribution: "random", Skipped: "input touches 4096 blocks of values", Environment: env},
+		{Algorithm: "Naive", Size: 10, Distribution: "sample", Ns: 50, MeanNs: 50, Environment: env},
+	}
+}
+
+func TestWinners(t *testing.T) {
+	got := winners(testRecords())
+	if len(got) != 3 {
+		t.Fatalf("winners = %+v, want 3", got)
+	}
+	w := got[0]
+	if w.Distribution != "random" || w.Size != 100 || w.Algorithm != "HashTable" || w.RunnerUp != "Naive" || w.Ratio != 2 {
+		t.Errorf("winner at random/100 = %+v, want HashTable 2x faster than Naive", w)
+	}
+	if got[1].Size != 1000 || got[1].RunnerUp != "" || got[2].Distribution != "sample" {
+		t.Errorf("winners = %+v, not sorted by distribution and size", got)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "Filters <before>", testRecords()); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		"Filters &lt;before&gt;",
+		"Test CPU",
+		"got 3 unique values, want 4",
+		"input touches 4096 blocks of values",
+		"<h2>Distribution sample</h2>",
+		"<svg",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("report has no %q", want)
+		}
+	}
+	for _, external := range []string{"<script", "http://", "https://"} {
+		if strings.Contains(strings.ReplaceAll(html, `xmlns="http://www.w3.org/2000/svg"`, ""), external) {
+			t.Errorf("report is not self-contained: it has %q", external)
+		}
+	}
+}
@@ -11,7 +11,7 @@
 #include <stdlib.h>
 #include <string.h>
 #include <time.h>
-#include "filter_uniq_ints.h"
+#include "unique-integers-filter.h"
 
 /**
  * @brief Convert a string to a posivie number
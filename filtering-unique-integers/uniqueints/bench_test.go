@@ -0,0 +1,98 @@
+package uniqueints
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+)
+
+// benchDistribution is an input distribution of BenchmarkFilters.
+type benchDistribution struct {
+	name     string
+	generate func(arr []int, numElems int) error
+}
+
+// benchDistributions are the input distributions of BenchmarkFilters: the
+// legacy generators, then every distribution of the generator package.
+var benchDistributions = []benchDistribution{
+	{"random", func(arr []int, numElems int) error {
+		return GenerateRandomInputArr(generator.NewRand(1), arr, numElems, numElems*10)
+	}},
+	{"growing", GenerateGrowingArr},
+	{"growing-dup", GenerateGrowingArrImproved},
+}
+
+func init() {
+	for _, dist := range generator.Distributions() {
+		benchDistributions = append(benchDistributions, benchDistribution{dist.Name, func(arr []int, numElems int) error {
+			copy(arr, dist.Generate(generator.NewRand(1), numElems))
+			return nil
+		}})
+	}
+}
+
+// benchSizes are the input sizes of BenchmarkFilters. The O(n²) filters
+// stop at maxQuadraticBenchSize.
+var benchSizes = []int{10, 100, 1000, 10000, 100000, 1000000}
+
+const maxQuadraticBenchSize = 10000
+
+// maxBenchSpan is the largest difference between the max and min values of
+// an input given to the MemorySpan filters, whose bitmap covers it.
+const maxBenchSpan = 1 << 26
+
+// maxBenchBlockBytes is the largest memory that the blocks touched by an
+// input may take in a MemoryBlocks filter, as given by its BlockBytes:
+// FilterUniqueElementsHT allocates 512 KiB per block, so multiples of 65536
+// would take gigabytes.
+const maxBenchBlockBytes = 512 << 20
+
+// countBlocks returns the number of 65536-wide blocks of values touched by
+// input.
+func countBlocks(input []int) int {
+	blocks := make(map[int]bool)
+	for _, value := range input {
+		blocks[value>>16] = true
+	}
+	return len(blocks)
+}
+
+// BenchmarkFilters benchmarks every registered filter for every size and
+// input distribution, for example:
+//
+//	go test ./uniqueints -run '^$' -bench 'Filters/^HashTable$/^size=1000$/'
+//	go test ./uniqueints -run '^$' -bench 'Filters/^HT$/^size=10000$/^dist=multiples-65536$'
+func BenchmarkFilters(b *testing.B) {
+	for _, filter := range Filters() {
+		info := filter.Info()
+		b.Run(info.Name, func(b *testing.B) {
+			for _, size := range benchSizes {
+				if info.Time == TimeQuadratic && size > maxQuadraticBenchSize {
+					continue
+				}
+				for _, dist := range benchDistributions {
+					b.Run(fmt.Sprintf("size=%d/dist=%s", size, dist.name), func(b *testing.B) {
+						input := make([]int, size)
+						if err := dist.generate(input, size); err != nil {
+							b.Fatal(err)
+						}
+						if info.Memory == MemorySpan && len(input) > 0 && slices.Max(input)-slices.Min(input) > maxBenchSpan {
+							b.Skip("input span too large for a MemorySpan filter")
+						}
+						if info.Memory == MemoryBlocks && countBlocks(input)*info.BlockBytes > maxBenchBlockBytes {
+							b.Skip("input touches too many blocks for a MemoryBlocks filter")
+						}
+						b.ReportAllocs()
+						b.ResetTimer()
+						for i := 0; i < b.N; i++ {
+							_ = filter.Filter(input)
+						}
+						b.ReportMetric(float64(size)*float64(b.N)/b.Elapsed().Seconds(), "elems/s")
+					})
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Author: Junior ADI
+Description: Filtering unique integers with a bit-based hash table
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+func init() {
+	registerFunc(Info{
+		Name:            "BitHashTable",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryBlocks,
+		BlockBytes:      1 << bitLeafBits / 8,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsBitHashTable)
+}
+
+type bitHashTableNode struct {
+	branchSizeP uint32
+	branchSizeN uint32
+	ptrBranchP  []uint8
+	ptrBranchN  []uint8
+}
+
+func newBitHashTableNode(branchSizeP, branchSizeN uint32) *bitHashTableNode {
+	return &bitHashTableNode{
+		branchSizeP: branchSizeP,
+		branchSizeN: branchSizeN,
+		ptrBranchP:  make([]uint8, (branchSizeP+7)/8),
+		ptrBranchN:  make([]uint8, (branchSizeN+7)/8),
+	}
+}
+
+func flipBit(byteArray []uint8, bitPosition int) {
+	byteIndex := bitPosition / 8
+	bitOffset := uint8(1 << uint(bitPosition%8))
+	byteArray[byteIndex] |= bitOffset
+}
+
+func checkBit(byteArray []uint8, bitPosition int) bool {
+	byteIndex := bitPosition / 8
+	bitOffset := uint8(1 << uint(bitPosition%8))
+	return (byteArray[byteIndex] & bitOffset) != 0
+}
+
+const (
+	// bitLeafBits is the number of low bits of a magnitude addressed by the
+	// branches of a single bitHashTableNode.
+	bitLeafBits = 16
+	// bitDirBits is the number of middle bits of a magnitude resolved by the
+	// directory of the nodes below 1<<32.
+	bitDirBits = 16
+)
+
+// bitHashTable is a multi-level bitmap over the full int range. A value is
+// split into its sign and its magnitude: bits 16..63 of the magnitude select
+// a node and bits 0..15 select the bit in the positive or negative branch of
+// that node. Nodes and their branches are only allocated for the ranges and
+// signs actually seen in the input, so sparse 64-bit values cost one node
+// each.
+type bitHashTable struct {
+	// low holds the nodes of the magnitudes below 1<<32, indexed by their
+	// bits 16..31 so the common case costs no map lookup. It grows with the
+	// largest magnitude seen.
+	low []*bitHashTableNode
+	// high holds the nodes of the larger magnitudes, keyed by their bits
+	// 16..63.
+	high map[uint64]*bitHashTableNode
+}
+
+func newBitHashTable() *bitHashTable {
+	return &bitHashTable{}
+}
+
+// node returns the node of magnitude, allocating it on first use.
+func (t *bitHashTable) node(magnitude uint64) *bitHashTableNode {
+	hashKey := magnitude >> bitLeafBits
+	if hashKey >= 1<<bitDirBits {
+		if t.high == nil {
+			t.high = make(map[uint64]*bitHashTableNode)
+		}
+		node := t.high[hashKey]
+		if node == nil {
+			node = newBitHashTableNode(0, 0)
+			t.high[hashKey] = node
+		}
+		return node
+	}
+
+	if int(hashKey) >= len(t.low) {
+		// Double the directory, so that increasing inputs do not copy it
+		// for every new node.
+		grown := make([]*bitHashTableNode, min(max(2*len(t.low), int(hashKey)+1), 1<<bitDirBits))
+		copy(grown, t.low)
+		t.low = grown
+	}
+	if t.low[hashKey] == nil {
+		t.low[hashKey] = newBitHashTableNode(0, 0)
+	}
+	return t.low[hashKey]
+}
+
+// branch returns the branch of node for the given sign, allocating it on
+// first use.
+func (node *bitHashTableNode) branch(negative bool) []uint8 {
+	if negative {
+		if node.branchSizeN == 0 {
+			node.branchSizeN = 1 << bitLeafBits
+			node.ptrBranchN = make([]uint8, node.branchSizeN/8)
+		}
+		return node.ptrBranchN
+	}
+	if node.branchSizeP == 0 {
+		node.branchSizeP = 1 << bitLeafBits
+		node.ptrBranchP = make([]uint8, node.branchSizeP/8)
+	}
+	return node.ptrBranchP
+}
+
+// testAndSet marks the value given by its sign and magnitude as seen and
+// reports whether it was seen for the first time.
+func (t *bitHashTable) testAndSet(negative bool, magnitude uint64) bool {
+	ptrBranch := t.node(magnitude).branch(negative)
+
+	modIndex := int(magnitude & (1<<bitLeafBits - 1))
+	if checkBit(ptrBranch, modIndex) {
+		return false
+	}
+	flipBit(ptrBranch, modIndex)
+	return true
+}
+
+// FilterUniqueElementsBitHashTable filters the unique integers from a given
+// array using a multi-level bitmap that stores one bit per value, which saves
+// 8x memory compared with a byte per value. Every int value, including
+// math.MinInt and math.MaxInt, maps to its own bit.
+func FilterUniqueElementsBitHashTable(input []int) []int {
+	return FilterUniqueBitHashTable(input)
+}
@@ -0,0 +1,525 @@
+/*
+Author: Junior ADI
+Description: Go ports of the bitmap filters of unique-integers-filter.c
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import (
+	"fmt"
+	"math"
+)
+
+// Constants of the bitmap filters, as defined in unique-integers-filter.h.
+const (
+	negativeStartPos = 8192
+	bitModTableSize  = 16384
+	bitModDivFactor  = 65536
+	bitmapInitLength = 128
+	bitmapLengthMax  = 32769
+)
+
+func init() {
+	registerFunc(Info{
+		Name:            "BitmapStatic",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      bitModTableSize,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapStatic))
+	registerFunc(Info{
+		Name:            "BitmapBaseDynamic",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      bitModTableSize,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapBaseDynamic))
+	registerFunc(Info{
+		Name:            "BitmapFullDynamic",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      bitModTableSize,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapFullDynamic))
+	registerFunc(Info{
+		Name:            "BitmapDbase",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      negativeStartPos,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapDbase))
+	registerFunc(Info{
+		Name:            "BitmapDbaseDynamic",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      negativeStartPos,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapDbaseDynamic))
+	registerFunc(Info{
+		Name:            "BitmapArray",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemorySpan,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapArray))
+	registerFunc(Info{
+		Name:            "BitmapArrayDynamic",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemorySpan,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsBitmapArrayDynamic))
+}
+
+// bitmapBaseNode is a row of a bitmap filter. A row covers bitModDivFactor
+// magnitudes of each sign: the positive values use the bytes before
+// negativeStartPos and the negative values the bytes after it.
+type bitmapBaseNode struct {
+	branchSize uint16
+	ptrBranch  []uint8
+}
+
+// bitmapPosition returns the row, byte index and bit position of elem in a
+// bitmap filter, like the index computation of fui_bitmap_stc.
+func bitmapPosition(elem int) (int, int, int) {
+	tmpQuotient := elem / bitModDivFactor
+	tmpMod := elem % bitModDivFactor
+	if tmpQuotient < 0 {
+		tmpQuotient = -tmpQuotient
+	}
+	if tmpMod < 0 {
+		tmpMod = -tmpMod
+	}
+	tmpByteIndex := tmpMod / 8
+	if elem < 0 {
+		tmpByteIndex += negativeStartPos
+	}
+	return tmpQuotient, tmpByteIndex, tmpMod % 8
+}
+
+// RangeError reports an input value outside the int32 range of the ports of
+// the C filters, which work on 32-bit C ints. The FilterUniqueElements
+// function of a port returns it, while its registered filter, whose Info has
+// Int32Range as its Range, panics with it, and its generic FilterUnique
+// version only takes the Integer32 types, which are always in range.
+type RangeError struct {
+	Index int
+	Value int
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("uniqueints: value %d at index %d is outside the int32 range of the ports of the C filters", e.Value, e.Index)
+}
+
+// checkInt32 returns a *RangeError for the first value of input outside the
+// int32 range, nil if there is none.
+func checkInt32(input []int) error {
+	for i, elem := range input {
+		if elem < math.MinInt32 || elem > math.MaxInt32 {
+			return &RangeError{Index: i, Value: elem}
+		}
+	}
+	return nil
+}
+
+// mustInt32 returns the registered form of fn, a port of a C filter, which
+// panics with the *RangeError of fn on a value outside Int32Range, the
+// Range of its Info.
+func mustInt32(fn func([]int) ([]int, error)) func([]int) []int {
+	return func(input []int) []int {
+		output, err := fn(input)
+		if err != nil {
+			panic(err)
+		}
+		return output
+	}
+}
+
+// FilterUniqueElementsBitmapStatic filters the unique integers from a given
+// array with a static table of bitmapLengthMax rows, each row being a bitmap
+// of bitModTableSize bytes allocated on first use. It is the Go port of
+// fui_bitmap_stc.
+func FilterUniqueElementsBitmapStatic(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapStatic(input), nil
+}
+
+// filterUniqueBitmapStatic is the implementation of the static bitmap
+// filters.
+func filterUniqueBitmapStatic[T Integer](input []T) []T {
+	var bitmapHead [bitmapLengthMax]bitmapBaseNode
+
+	var output []T
+
+	for _, elem := range input {
+		tmpQuotient, tmpByteIndex, tmpBitPosition := bitmapPosition(int(elem))
+
+		row := &bitmapHead[tmpQuotient]
+		if row.ptrBranch == nil {
+			row.ptrBranch = make([]uint8, bitModTableSize)
+			row.branchSize = bitModTableSize
+		}
+
+		bitPosition := tmpByteIndex*8 + tmpBitPosition
+		if checkBit(row.ptrBranch, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(row.ptrBranch, bitPosition)
+	}
+
+	return output
+}
+
+// growTable returns table extended so that index is a valid index, like the
+// realloc of the bitmap head in fui_bitmap_base_dyn.
+func growTable[T any](table []T, index int) []T {
+	if index < len(table) {
+		return table
+	}
+	grown := make([]T, index+1)
+	copy(grown, table)
+	return grown
+}
+
+// FilterUniqueElementsBitmapBaseDynamic filters the unique integers from a
+// given array with a table of bitmap rows that starts with bitmapInitLength
+// rows and grows to the largest row needed, up to bitmapLengthMax. Each row
+// is a bitmap of bitModTableSize bytes allocated on first use. It is the Go
+// port of fui_bitmap_base_dyn.
+func FilterUniqueElementsBitmapBaseDynamic(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapBaseDynamic(input), nil
+}
+
+// filterUniqueBitmapBaseDynamic is the implementation of the base dynamic
+// bitmap filters.
+func filterUniqueBitmapBaseDynamic[T Integer](input []T) []T {
+	bitmapHead := make([]bitmapBaseNode, bitmapInitLength)
+
+	var output []T
+
+	for _, elem := range input {
+		tmpQuotient, tmpByteIndex, tmpBitPosition := bitmapPosition(int(elem))
+
+		// Grow the table if needed.
+		bitmapHead = growTable(bitmapHead, tmpQuotient)
+		row := &bitmapHead[tmpQuotient]
+		if row.ptrBranch == nil {
+			row.ptrBranch = make([]uint8, bitModTableSize)
+			row.branchSize = bitModTableSize
+		}
+
+		bitPosition := tmpByteIndex*8 + tmpBitPosition
+		if checkBit(row.ptrBranch, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(row.ptrBranch, bitPosition)
+	}
+
+	return output
+}
+
+// growBranch returns branch extended so that byteIndex is a valid index. A
+// branch starts with bitmapInitLength bytes and doubles until it reaches
+// maxSize bytes.
+func growBranch(branch []uint8, byteIndex, maxSize int) []uint8 {
+	if byteIndex < len(branch) {
+		return branch
+	}
+	newSize := len(branch)
+	if newSize == 0 {
+		newSize = bitmapInitLength
+	}
+	for newSize <= byteIndex {
+		newSize *= 2
+	}
+	if newSize > maxSize {
+		newSize = maxSize
+	}
+	grown := make([]uint8, newSize)
+	copy(grown, branch)
+	return grown
+}
+
+// FilterUniqueElementsBitmapFullDynamic filters the unique integers from a
+// given array with a table of bitmap rows where both the table and each row
+// grow on demand. The table grows like in FilterUniqueElementsBitmapBaseDynamic
+// and each row only grows up to the largest magnitude seen in its range. To
+// let a row grow for both signs, the bits of a positive value and of the
+// negative value of the same magnitude are stored side by side instead of in
+// two halves split at negativeStartPos. It is the Go version of
+// fui_bitmap_full_dyn.
+func FilterUniqueElementsBitmapFullDynamic(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapFullDynamic(input), nil
+}
+
+// filterUniqueBitmapFullDynamic is the implementation of the full dynamic
+// bitmap filters.
+func filterUniqueBitmapFullDynamic[T Integer](input []T) []T {
+	bitmapHead := make([]bitmapBaseNode, bitmapInitLength)
+
+	var output []T
+
+	for _, elem := range input {
+		negative, magnitude := magnitudeOf(elem)
+		tmpQuotient := int(magnitude / bitModDivFactor)
+		bitPosition := int(magnitude%bitModDivFactor) * 2
+		if negative {
+			bitPosition++
+		}
+
+		bitmapHead = growTable(bitmapHead, tmpQuotient)
+		row := &bitmapHead[tmpQuotient]
+		row.ptrBranch = growBranch(row.ptrBranch, bitPosition/8, bitModTableSize)
+		row.branchSize = uint16(len(row.ptrBranch))
+
+		if checkBit(row.ptrBranch, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(row.ptrBranch, bitPosition)
+	}
+
+	return output
+}
+
+// bitmapDbaseNode is a row of a double-base bitmap filter. A row covers
+// bitModDivFactor magnitudes of each sign, with a separate branch for the
+// negative and the positive values.
+type bitmapDbaseNode struct {
+	branchSizeN uint16
+	branchSizeP uint16
+	ptrBranchN  []uint8
+	ptrBranchP  []uint8
+}
+
+// dbaseBranch returns the branch of row for the given sign, growing it so
+// that byteIndex is a valid index. A static branch is allocated at its full
+// size of negativeStartPos bytes, a dynamic one grows with growBranch.
+func dbaseBranch(row *bitmapDbaseNode, negative bool, byteIndex int, dynamic bool) []uint8 {
+	branch, branchSize := &row.ptrBranchP, &row.branchSizeP
+	if negative {
+		branch, branchSize = &row.ptrBranchN, &row.branchSizeN
+	}
+	switch {
+	case dynamic:
+		*branch = growBranch(*branch, byteIndex, negativeStartPos)
+	case *branch == nil:
+		*branch = make([]uint8, negativeStartPos)
+	}
+	*branchSize = uint16(len(*branch))
+	return *branch
+}
+
+// filterUniqueBitmapDbase is the common implementation of the
+// double-base bitmap filters.
+func filterUniqueBitmapDbase[T Integer](input []T, dynamic bool) []T {
+	var bitmapHead []bitmapDbaseNode
+	if dynamic {
+		bitmapHead = make([]bitmapDbaseNode, bitmapInitLength)
+	} else {
+		bitmapHead = make([]bitmapDbaseNode, bitmapLengthMax)
+	}
+
+	var output []T
+
+	for _, elem := range input {
+		negative, magnitude := magnitudeOf(elem)
+		tmpQuotient := int(magnitude / bitModDivFactor)
+		tmpMod := int(magnitude % bitModDivFactor)
+
+		if dynamic {
+			bitmapHead = growTable(bitmapHead, tmpQuotient)
+		}
+		ptrBranch := dbaseBranch(&bitmapHead[tmpQuotient], negative, tmpMod/8, dynamic)
+
+		if checkBit(ptrBranch, tmpMod) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(ptrBranch, tmpMod)
+	}
+
+	return output
+}
+
+// FilterUniqueElementsBitmapDbase filters the unique integers from a given
+// array with a static table of bitmapLengthMax double-base rows. Each branch
+// of a row is allocated at its full size on first use. It is the Go version
+// of fui_bitmap_dbase.
+func FilterUniqueElementsBitmapDbase(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapDbase(input, false), nil
+}
+
+// FilterUniqueElementsBitmapDbaseDynamic filters the unique integers from a
+// given array with a growing table of double-base rows whose negative and
+// positive branches grow independently, each one only up to the largest
+// magnitude seen for its sign. It is the Go version of fui_bitmap_dbase_dyn.
+func FilterUniqueElementsBitmapDbaseDynamic(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapDbase(input, true), nil
+}
+
+// FilterUniqueElementsBitmapArray filters the unique integers from a given
+// array with a single contiguous bitmap. A first pass finds the min and max
+// values, then one bitmap covering exactly that range is allocated. It is
+// the Go version of fui_bitmap_array.
+func FilterUniqueElementsBitmapArray(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapArray(input), nil
+}
+
+// filterUniqueBitmapArray is the implementation of the flat-array bitmap
+// filters.
+func filterUniqueBitmapArray[T Integer](input []T) []T {
+	if len(input) == 0 {
+		return nil
+	}
+
+	min, max := int64(input[0]), int64(input[0])
+	for _, elem := range input {
+		if int64(elem) < min {
+			min = int64(elem)
+		}
+		if int64(elem) > max {
+			max = int64(elem)
+		}
+	}
+
+	bitmap := make([]uint8, (max-min)/8+1)
+
+	var output []T
+
+	for _, elem := range input {
+		bitPosition := int(int64(elem) - min)
+		if checkBit(bitmap, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(bitmap, bitPosition)
+	}
+
+	return output
+}
+
+// bitmapArray is a single contiguous bitmap whose first bit stands for the
+// value base. It grows to cover the min/max range observed so far.
+type bitmapArray struct {
+	base   int64
+	bitmap []uint8
+}
+
+// floorToByte rounds value down to a multiple of 8, so that the base of a
+// bitmapArray always falls on a byte boundary.
+func floorToByte(value int64) int64 {
+	return value - value&7
+}
+
+// grow extends the bitmap so that it covers elem. The covered range at
+// least doubles on each growth and never exceeds the int32 range.
+func (a *bitmapArray) grow(elem int64) {
+	if a.bitmap == nil {
+		a.base = floorToByte(elem)
+		if a.base+bitmapInitLength*8 > math.MaxInt32+1 {
+			a.base = math.MaxInt32 + 1 - bitmapInitLength*8
+		}
+		a.bitmap = make([]uint8, bitmapInitLength)
+		return
+	}
+
+	length := int64(len(a.bitmap)) * 8
+	newBase, newEnd := a.base, a.base+length
+	if elem < newBase {
+		newBase = floorToByte(elem)
+		if a.base-length < newBase {
+			newBase = a.base - length
+		}
+		if newBase < math.MinInt32 {
+			newBase = math.MinInt32
+		}
+	}
+	if elem >= newEnd {
+		newEnd = floorToByte(elem) + 8
+		if a.base+2*length > newEnd {
+			newEnd = a.base + 2*length
+		}
+		if newEnd > math.MaxInt32+1 {
+			newEnd = math.MaxInt32 + 1
+		}
+	}
+
+	grown := make([]uint8, (newEnd-newBase)/8)
+	copy(grown[(a.base-newBase)/8:], a.bitmap)
+	a.base, a.bitmap = newBase, grown
+}
+
+// FilterUniqueElementsBitmapArrayDynamic filters the unique integers from a
+// given array in a single pass with one contiguous bitmap that grows to
+// cover the min/max range observed so far. It is the Go version of
+// fui_bitmap_array_dyn.
+func FilterUniqueElementsBitmapArrayDynamic(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueBitmapArrayDynamic(input), nil
+}
+
+// filterUniqueBitmapArrayDynamic is the implementation of the dynamic
+// flat-array bitmap filters.
+func filterUniqueBitmapArrayDynamic[T Integer](input []T) []T {
+	var array bitmapArray
+
+	var output []T
+
+	for _, elem := range input {
+		value := int64(elem)
+		if array.bitmap == nil || value < array.base || value >= array.base+int64(len(array.bitmap))*8 {
+			array.grow(value)
+		}
+
+		bitPosition := int(value - array.base)
+		if checkBit(array.bitmap, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(array.bitmap, bitPosition)
+	}
+
+	return output
+}
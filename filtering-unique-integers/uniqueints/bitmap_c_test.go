@@ -0,0 +1,201 @@
+package uniqueints
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestInt32PortsOutOfRange(t *testing.T) {
+	for name, fn := range map[string]func([]int) ([]int, error){
+		"BitmapStatic":       FilterUniqueElementsBitmapStatic,
+		"BitmapBaseDynamic":  FilterUniqueElementsBitmapBaseDynamic,
+		"BitmapFullDynamic":  FilterUniqueElementsBitmapFullDynamic,
+		"BitmapDbase":        FilterUniqueElementsBitmapDbase,
+		"BitmapDbaseDynamic": FilterUniqueElementsBitmapDbaseDynamic,
+		"BitmapArray":        FilterUniqueElementsBitmapArray,
+		"BitmapArrayDynamic": FilterUniqueElementsBitmapArrayDynamic,
+		"HT":                 FilterUniqueElementsHT,
+		"HTNew":              FilterUniqueElementsHTNew,
+		"HTDyn":              FilterUniqueElementsHTDyn,
+	} {
+		output, err := fn([]int{1, 2, math.MaxInt32 + 1, math.MinInt32 - 1})
+		var rangeErr *RangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Index != 2 || rangeErr.Value != math.MaxInt32+1 || output != nil {
+			t.Errorf("%s = %v, %v, want a *RangeError at index 2", name, output, err)
+		}
+		filter, _ := Lookup(name)
+		func() {
+			defer func() {
+				if r := recover(); !errors.As(asError(r), &rangeErr) {
+					t.Errorf("registered %s filter recovered %v, want a *RangeError", name, r)
+				}
+			}()
+			filter.Filter([]int{math.MinInt32 - 1})
+		}()
+	}
+}
+
+// asError returns r, a recovered value, as an error, nil if it is not one.
+func asError(r any) error {
+	err, _ := r.(error)
+	return err
+}
+
+func TestBitmapPosition(t *testing.T) {
+	tests := []struct {
+		elem                   int
+		row, byteIndex, bitPos int
+	}{
+		{0, 0, 0, 0},
+		{5, 0, 0, 5},
+		{-5, 0, negativeStartPos, 5},
+		{65535, 0, 8191, 7},
+		{-65535, 0, negativeStartPos + 8191, 7},
+		{65541, 1, 0, 5},
+		{-65541, 1, negativeStartPos, 5},
+		{math.MaxInt32, 32767, 8191, 7},
+		// The magnitude of math.MinInt32 is 32768 x 65536: the last row.
+		{math.MinInt32, bitmapLengthMax - 1, negativeStartPos, 0},
+	}
+	for _, tt := range tests {
+		row, byteIndex, bitPos := bitmapPosition(tt.elem)
+		if row != tt.row || byteIndex != tt.byteIndex || bitPos != tt.bitPos {
+			t.Errorf("bitmapPosition(%d) = %d, %d, %d, want %d, %d, %d", tt.elem, row, byteIndex, bitPos, tt.row, tt.byteIndex, tt.bitPos)
+		}
+	}
+}
+
+func TestBitmapDynamicGrowth(t *testing.T) {
+	if table := growTable(make([]bitmapBaseNode, bitmapInitLength), 5); len(table) != bitmapInitLength {
+		t.Errorf("growTable to row 5 has %d rows, want %d", len(table), bitmapInitLength)
+	}
+	if table := growTable(make([]bitmapBaseNode, bitmapInitLength), bitmapLengthMax-1); len(table) != bitmapLengthMax {
+		t.Errorf("growTable to the last row has %d rows, want %d", len(table), bitmapLengthMax)
+	}
+
+	branch := growBranch(nil, 0, bitModTableSize)
+	if len(branch) != bitmapInitLength {
+		t.Errorf("new branch of %d bytes, want %d", len(branch), bitmapInitLength)
+	}
+	branch[3] = 0xa5
+	branch = growBranch(branch, 300, bitModTableSize)
+	if len(branch) != 4*bitmapInitLength || branch[3] != 0xa5 {
+		t.Errorf("branch grown to byte 300 has %d bytes and byte 3 %#x, want %d and 0xa5", len(branch), branch[3], 4*bitmapInitLength)
+	}
+	if branch = growBranch(branch, bitModTableSize-1, bitModTableSize); len(branch) != bitModTableSize {
+		t.Errorf("branch grown to its last byte has %d bytes, want %d", len(branch), bitModTableSize)
+	}
+
+	// The table grows to the row of the largest magnitude seen, and each row
+	// of the full dynamic filter grows with the largest magnitude of both
+	// signs in its range.
+	input := []int{1, -1, 1 << 30, -(1 << 30), 65535, -65535, math.MinInt32, 1, 1 << 30, math.MinInt32, -65535}
+	want := []int{1, -1, 1 << 30, -(1 << 30), 65535, -65535, math.MinInt32}
+	for name, fn := range map[string]func([]int) ([]int, error){
+		"BitmapBaseDynamic": FilterUniqueElementsBitmapBaseDynamic,
+		"BitmapFullDynamic": FilterUniqueElementsBitmapFullDynamic,
+	} {
+		if got, err := fn(input); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s(%v) = %v, %v, want %v", name, input, got, err, want)
+		}
+	}
+}
+
+func TestBitmapDbaseBranches(t *testing.T) {
+	// A static branch is allocated at its full size for its sign only.
+	var row bitmapDbaseNode
+	if branch := dbaseBranch(&row, false, 0, false); len(branch) != negativeStartPos || row.branchSizeP != negativeStartPos || row.ptrBranchN != nil {
+		t.Errorf("static positive branch of %d bytes and size %d, negative branch %v, want %d bytes and none", len(branch), row.branchSizeP, row.ptrBranchN, negativeStartPos)
+	}
+
+	// A dynamic branch grows with the largest magnitude of its sign.
+	row = bitmapDbaseNode{}
+	if branch := dbaseBranch(&row, true, 0, true); len(branch) != bitmapInitLength || row.branchSizeN != bitmapInitLength || row.ptrBranchP != nil {
+		t.Errorf("dynamic negative branch of %d bytes and size %d, positive branch %v, want %d bytes and none", len(branch), row.branchSizeN, row.ptrBranchP, bitmapInitLength)
+	}
+	if branch := dbaseBranch(&row, true, negativeStartPos-1, true); len(branch) != negativeStartPos || row.branchSizeN != negativeStartPos {
+		t.Errorf("dynamic negative branch grown to its last byte has %d bytes and size %d, want %d", len(branch), row.branchSizeN, negativeStartPos)
+	}
+	if branch := dbaseBranch(&row, false, 200, true); len(branch) != 2*bitmapInitLength {
+		t.Errorf("dynamic positive branch grown to byte 200 has %d bytes, want %d", len(branch), 2*bitmapInitLength)
+	}
+}
+
+func TestBitmapDbaseFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		input, want []int
+	}{
+		// 0 and the last magnitudes of a row, and the first of the next one.
+		{"row boundaries", []int{0, 65535, -65535, 65536, -65536, 0, -65536, 65535, -65535}, []int{0, 65535, -65535, 65536, -65536}},
+		// The same magnitude goes to the branch of its sign.
+		{"signs", []int{5, -5, 5, -5, 65541, -65541}, []int{5, -5, 65541, -65541}},
+		// The magnitude of math.MinInt32 is the first of the last row.
+		{"int32 limits", []int{math.MaxInt32, math.MinInt32, -math.MaxInt32, math.MinInt32, math.MaxInt32}, []int{math.MaxInt32, math.MinInt32, -math.MaxInt32}},
+	}
+	for name, fn := range map[string]func([]int) ([]int, error){
+		"BitmapDbase":        FilterUniqueElementsBitmapDbase,
+		"BitmapDbaseDynamic": FilterUniqueElementsBitmapDbaseDynamic,
+	} {
+		for _, tt := range tests {
+			if got, err := fn(tt.input); err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s/%s: got %v, %v, want %v", name, tt.name, got, err, tt.want)
+			}
+		}
+	}
+}
+
+func TestBitmapArrayGrow(t *testing.T) {
+	var array bitmapArray
+	array.grow(100)
+	if array.base != 96 || len(array.bitmap) != bitmapInitLength {
+		t.Errorf("first grow at 100: base %d and %d bytes, want 96 and %d", array.base, len(array.bitmap), bitmapInitLength)
+	}
+	flipBit(array.bitmap, 4)
+
+	// Growing downwards keeps the bits already set, at their new position.
+	array.grow(-1000)
+	if array.base != -1000 || len(array.bitmap) != (96+1000)/8+bitmapInitLength || !checkBit(array.bitmap, 100-(-1000)) {
+		t.Errorf("grow at -1000: base %d and %d bytes, want -1000 and %d with 100 still set", array.base, len(array.bitmap), (96+1000)/8+bitmapInitLength)
+	}
+
+	// The bitmap never covers values outside the int32 range.
+	array = bitmapArray{}
+	array.grow(math.MaxInt32)
+	if array.base != math.MaxInt32+1-bitmapInitLength*8 || len(array.bitmap) != bitmapInitLength {
+		t.Errorf("first grow at math.MaxInt32: base %d and %d bytes, want %d and %d", array.base, len(array.bitmap), math.MaxInt32+1-bitmapInitLength*8, bitmapInitLength)
+	}
+	array = bitmapArray{base: math.MinInt32 + 64, bitmap: make([]uint8, bitmapInitLength)}
+	array.grow(math.MinInt32)
+	if array.base != math.MinInt32 || len(array.bitmap) != bitmapInitLength+8 {
+		t.Errorf("grow at math.MinInt32: base %d and %d bytes, want %d and %d", array.base, len(array.bitmap), math.MinInt32, bitmapInitLength+8)
+	}
+}
+
+func TestBitmapArrayFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		input, want []int
+	}{
+		{"boundaries", []int{0, -65536, 65535, -65536, 0, 65535}, []int{0, -65536, 65535}},
+		{"single value", []int{-7, -7, -7}, []int{-7}},
+		// The range of the bitmap stops at the int32 limits; both limits
+		// at once would take a 512 MiB bitmap.
+		{"max int32", []int{math.MaxInt32, math.MaxInt32 - 1, math.MaxInt32 - 8, math.MaxInt32}, []int{math.MaxInt32, math.MaxInt32 - 1, math.MaxInt32 - 8}},
+		{"min int32", []int{math.MinInt32 + 8, math.MinInt32, math.MinInt32 + 1, math.MinInt32}, []int{math.MinInt32 + 8, math.MinInt32, math.MinInt32 + 1}},
+		// The dynamic bitmap grows in both directions.
+		{"growing both ways", []int{100, 50, -1000, -100000, 100, -1000, 3000000, -100000}, []int{100, 50, -1000, -100000, 3000000}},
+	}
+	for name, fn := range map[string]func([]int) ([]int, error){
+		"BitmapArray":        FilterUniqueElementsBitmapArray,
+		"BitmapArrayDynamic": FilterUniqueElementsBitmapArrayDynamic,
+	} {
+		for _, tt := range tests {
+			if got, err := fn(tt.input); err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s/%s: got %v, %v, want %v", name, tt.name, got, err, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package uniqueints
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestBitHashTableIntEdges(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		// The magnitude of math.MinInt is 1<<63, outside the int range.
+		{"min int", []int{math.MinInt, math.MinInt, 0, math.MinInt}, []int{math.MinInt, 0}},
+		{"max int", []int{math.MaxInt, -math.MaxInt, math.MaxInt, -math.MaxInt}, []int{math.MaxInt, -math.MaxInt}},
+		// The same magnitude with both signs.
+		{"signs", []int{1 << 40, -(1 << 40), 1 << 40, -1, 1, -1}, []int{1 << 40, -(1 << 40), -1, 1}},
+		// The same low 32 bits in different directories.
+		{"directories", []int{5, 1<<32 + 5, 1<<48 + 5, 5, 1<<32 + 5, -(1<<32 + 5)}, []int{5, 1<<32 + 5, 1<<48 + 5, -(1<<32 + 5)}},
+		// The same low 16 bits in different nodes of a directory.
+		{"nodes", []int{7, 1<<16 + 7, 1<<31 + 7, 7, 1<<16 + 7}, []int{7, 1<<16 + 7, 1<<31 + 7}},
+	}
+	for _, tt := range tests {
+		if got := FilterUniqueElementsBitHashTable(tt.input); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: FilterUniqueElementsBitHashTable(%v) = %v, want %v", tt.name, tt.input, got, tt.want)
+		}
+	}
+}
+
+// bytesAllocated returns the number of bytes allocated by fn.
+func bytesAllocated(fn func()) uint64 {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	fn()
+	runtime.ReadMemStats(&after)
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+func TestBitHashTableSparseAllocations(t *testing.T) {
+	// Random 64-bit IDs each fall in a node of their own, which only
+	// allocates the branch of their sign.
+	ids := make([]int, 1000)
+	rng := rand.New(rand.NewSource(1))
+	for i := range ids {
+		ids[i] = int(rng.Int63())
+	}
+	var got []int
+	if n := bytesAllocated(func() { got = FilterUniqueElementsBitHashTable(ids) }); n > 16<<20 {
+		t.Errorf("FilterUniqueElementsBitHashTable allocated %d bytes for 1000 random 64-bit IDs, want at most 16 MiB", n)
+	}
+	if !slices.Equal(got, ids) {
+		t.Errorf("FilterUniqueElementsBitHashTable dropped some of 1000 distinct IDs")
+	}
+
+	// A few small values allocate a single branch, not a whole directory.
+	if n := bytesAllocated(func() { FilterUniqueElementsBitHashTable([]int{1, 2, 3}) }); n > 64<<10 {
+		t.Errorf("FilterUniqueElementsBitHashTable allocated %d bytes for 3 small values, want at most 64 KiB", n)
+	}
+}
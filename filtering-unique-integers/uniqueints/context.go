@@ -0,0 +1,97 @@
+/*
+Author: Junior ADI
+Description: Cancellation of the filters with a context
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import "context"
+
+// ContextFilter is a Filter that can be cancelled while it runs.
+type ContextFilter interface {
+	Filter
+	// FilterContext returns the unique integers of input, or ctx.Err() if
+	// ctx is done before the filter completes. The filter checks ctx
+	// periodically, so it returns soon after ctx is done.
+	FilterContext(ctx context.Context, input []int) ([]int, error)
+}
+
+type contextFuncFilter struct {
+	info Info
+	fn   func(context.Context, []int) ([]int, error)
+}
+
+func (f contextFuncFilter) Info() Info { return f.info }
+
+func (f contextFuncFilter) Filter(input []int) []int {
+	output, _ := f.fn(context.Background(), input)
+	return output
+}
+
+func (f contextFuncFilter) FilterContext(ctx context.Context, input []int) ([]int, error) {
+	return f.fn(ctx, input)
+}
+
+// NewContextFilter returns a ContextFilter described by info and implemented
+// by fn.
+func NewContextFilter(info Info, fn func(context.Context, []int) ([]int, error)) ContextFilter {
+	return contextFuncFilter{info: info, fn: fn}
+}
+
+// registerContextFunc registers fn as a cancellable filter described by info.
+func registerContextFunc(info Info, fn func(context.Context, []int) ([]int, error)) {
+	Register(NewContextFilter(info, fn))
+}
+
+// FilterContext runs filter on input until ctx is done. A ContextFilter is
+// cancelled as soon as it notices that ctx is done. Any other filter runs to
+// completion, as the linear filters complete in seconds even on 10^7
+// values, and its output is discarded if ctx is done by then.
+func FilterContext(ctx context.Context, filter Filter, input []int) ([]int, error) {
+	if f, ok := filter.(ContextFilter); ok {
+		return f.FilterContext(ctx, input)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	output := filter.Filter(input)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// cancelCheckWork is the number of elementary steps, such as comparisons or
+// tree levels, between two checks of the context of a filter.
+const cancelCheckWork = 1 << 16
+
+// canceller tells a filter when to check its context.
+type canceller struct {
+	ctx  context.Context
+	work int
+}
+
+// newCanceller returns a canceller checking ctx at the first step, then
+// every cancelCheckWork steps.
+func newCanceller(ctx context.Context) canceller {
+	return canceller{ctx: ctx, work: cancelCheckWork}
+}
+
+// step records work elementary steps and returns ctx.Err() once
+// cancelCheckWork steps are done since the last check, nil otherwise.
+func (c *canceller) step(work int) error {
+	c.work += work
+	if c.work < cancelCheckWork {
+		return nil
+	}
+	c.work = 0
+	return c.ctx.Err()
+}
@@ -0,0 +1,53 @@
+package uniqueints
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFilterContext(t *testing.T) {
+	input := make([]int, 1<<12)
+	for i := range input {
+		input[i] = i % 1000
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, filter := range Filters() {
+		output, err := FilterContext(context.Background(), filter, input)
+		if err != nil || !reflect.DeepEqual(output, filter.Filter(input)) {
+			t.Errorf("%s: FilterContext = %d values, %v, want the output of Filter", filter.Info().Name, len(output), err)
+		}
+		if output, err := FilterContext(cancelled, filter, input); !errors.Is(err, context.Canceled) || output != nil {
+			t.Errorf("%s: FilterContext with a cancelled context = %d values, %v, want context.Canceled", filter.Info().Name, len(output), err)
+		}
+	}
+}
+
+// TestFilterContextDeadline checks that the quadratic filters return soon
+// after their deadline on an input that would take minutes.
+func TestFilterContextDeadline(t *testing.T) {
+	input := make([]int, 1<<22)
+	for i := range input {
+		input[i] = i
+	}
+	for _, filter := range Filters() {
+		if filter.Info().Time != TimeQuadratic {
+			continue
+		}
+		if _, ok := filter.(ContextFilter); !ok {
+			t.Errorf("%s: quadratic filter is not a ContextFilter", filter.Info().Name)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		startTime := time.Now()
+		_, err := FilterContext(ctx, filter, input)
+		elapsed := time.Since(startTime)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) || elapsed > time.Second {
+			t.Errorf("%s: FilterContext returned %v after %v, want context.DeadlineExceeded soon after 20ms", filter.Info().Name, err, elapsed)
+		}
+	}
+}
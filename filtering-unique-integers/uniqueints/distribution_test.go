@@ -0,0 +1,50 @@
+package uniqueints
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+)
+
+// maxTestBlockBytes is the largest memory that the blocks touched by an
+// input given to a MemoryBlocks filter by TestFiltersDistributions may take,
+// as given by its BlockBytes.
+const maxTestBlockBytes = 128 << 20
+
+// testDuplicateRatios are the duplicate ratio distributions checked by
+// TestFiltersDistributions, besides the named ones.
+var testDuplicateRatios = []string{"dup-0-fixed", "dup-0.5-fixed", "dup-0.9-geometric", "dup-0.99-power-law"}
+
+// TestFiltersDistributions checks every filter against the oracle on every
+// distribution of the generator package. The inputs of the MemoryBlocks
+// filters are cut down to maxTestBlockBytes of blocks, as the multiples of
+// 65536 would otherwise make FilterUniqueElementsHT allocate gigabytes.
+func TestFiltersDistributions(t *testing.T) {
+	const size = 5000
+	dists := generator.Distributions()
+	for _, name := range testDuplicateRatios {
+		dist, ok := generator.Lookup(name)
+		if !ok {
+			t.Fatalf("unknown distribution %q", name)
+		}
+		dists = append(dists, dist)
+	}
+	for _, dist := range dists {
+		input := dist.Generate(rand.New(rand.NewPCG(5, 6)), size)
+		for _, filter := range Filters() {
+			info := filter.Info()
+			t.Run(dist.Name+"/"+info.Name, func(t *testing.T) {
+				valueRange := info.Range
+				if info.Memory == MemorySpan {
+					valueRange = fuzzSpanRange
+				}
+				input := input
+				for info.Memory == MemoryBlocks && countBlocks(input)*info.BlockBytes > maxTestBlockBytes {
+					input = input[:len(input)/2]
+				}
+				checkFilter(t, filter, fitInput(input, valueRange))
+			})
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Author: Junior ADI
+Description: Filtering unique integers from a given array
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+// Package uniqueints filters the unique integers out of a given array.
+//
+// Every filter keeps the first occurrence of each value and returns the
+// values in the order they first appear in the input. The package also
+// provides the input generators used by the demo and benchmark commands.
+package uniqueints
+
+import "context"
+
+func init() {
+	registerContextFunc(Info{
+		Name:            "Naive",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeQuadratic,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsContext)
+	registerContextFunc(Info{
+		Name:            "Improved",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeQuadratic,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsImprovedContext)
+	registerFunc(Info{
+		Name:            "HashTable",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsHashTable)
+}
+
+// FilterUniqueElements filters the unique integers from a given array in the
+// brute/naive way: every element is compared against the elements already
+// kept.
+func FilterUniqueElements(input []int) []int {
+	output, _ := FilterUniqueElementsContext(context.Background(), input)
+	return output
+}
+
+// FilterUniqueElementsContext is FilterUniqueElements returning ctx.Err()
+// once ctx is done.
+func FilterUniqueElementsContext(ctx context.Context, input []int) ([]int, error) {
+	c := newCanceller(ctx)
+	var output []int
+	for _, elem := range input {
+		if err := c.step(len(output) + 1); err != nil {
+			return nil, err
+		}
+		found := false
+		for _, val := range output {
+			if val == elem {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, elem)
+		}
+	}
+	return output, nil
+}
+
+// FilterUniqueElementsImproved filters the unique integers from a given array
+// with the naive algorithm while tracking the current max and min values.
+func FilterUniqueElementsImproved(input []int) []int {
+	output, _ := FilterUniqueElementsImprovedContext(context.Background(), input)
+	return output
+}
+
+// FilterUniqueElementsImprovedContext is FilterUniqueElementsImproved
+// returning ctx.Err() once ctx is done.
+func FilterUniqueElementsImprovedContext(ctx context.Context, input []int) ([]int, error) {
+	c := newCanceller(ctx)
+	var output []int
+	var max, min int
+
+	for _, elem := range input {
+		if err := c.step(len(output) + 1); err != nil {
+			return nil, err
+		}
+		// Update max and min if necessary
+		if elem > max {
+			max = elem
+		}
+		if elem < min || len(output) == 0 {
+			min = elem
+		}
+
+		found := false
+		for _, val := range output {
+			if val == elem {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, elem)
+		}
+	}
+	return output, nil
+}
+
+// FilterUniqueElementsHashTable filters the unique integers from a given
+// array using the built-in Go map as the hash table.
+func FilterUniqueElementsHashTable(input []int) []int {
+	return FilterUniqueHashTable(input)
+}
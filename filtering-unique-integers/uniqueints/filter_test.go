@@ -0,0 +1,225 @@
+package uniqueints
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// oracle is the reference every filter is checked against.
+var oracle = FilterUniqueElementsHashTable
+
+// fitRange maps value into r, leaving the values already in r unchanged, so
+// that arbitrary inputs can be given to the filters with a limited range.
+func fitRange(value int, r ValueRange) int {
+	if r.Contains(value) {
+		return value
+	}
+	span := uint64(r.Max) - uint64(r.Min) + 1
+	return r.Min + int((uint64(value)-uint64(r.Min))%span)
+}
+
+// fitInput returns a copy of input with every value mapped into r.
+func fitInput(input []int, r ValueRange) []int {
+	if input == nil {
+		return nil
+	}
+	fitted := make([]int, len(input))
+	for i, value := range input {
+		fitted[i] = fitRange(value, r)
+	}
+	return fitted
+}
+
+// checkFilter fails the test if filter does not return the same values as
+// the oracle for input, in the same order if the filter is order-preserving.
+func checkFilter(t *testing.T, filter Filter, input []int) {
+	t.Helper()
+	info := filter.Info()
+	want := oracle(input)
+	got := filter.Filter(input)
+	if !info.OrderPreserving {
+		got = slices.Clone(got)
+		want = slices.Clone(want)
+		slices.Sort(got)
+		slices.Sort(want)
+	}
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		if len(input) > 32 {
+			t.Errorf("%s: output of %d values differs from the oracle: got %d values, want %d", info.Name, len(input), len(got), len(want))
+			return
+		}
+		t.Errorf("%s(%v) = %v, want %v", info.Name, input, got, want)
+	}
+}
+
+// edgeCases returns the table cases for a filter supporting the range r.
+func edgeCases(r ValueRange) map[string][]int {
+	apart := []int{5, 65541, 131077, -5, -65541, -131077, 1 << 16, 1 << 17, 1 << 32, 1<<32 + 65536, 1 << 48, 5, 65541, -131077, 1 << 32}
+	return map[string][]int{
+		"nil":           nil,
+		"empty":         {},
+		"single":        {42},
+		"sample":        {16, 17, 2, 17, 4, 2, 97, 4, 17, 56},
+		"zero":          {0, 0, 0},
+		"negatives":     {-1, -2, -1, -3, -2, -65536, -65535, -65536},
+		"signs":         {1, -1, 0, -1, 1, 0, 65536, -65536, 65536},
+		"range edges":   {r.Min, r.Max, 0, r.Min + 1, r.Max - 1, r.Max, r.Min, -1, 1, r.Min},
+		"65536 apart":   fitInput(apart, r),
+		"all duplicate": {7, 7, 7, 7, 7, 7, 7},
+		"int edges":     fitInput([]int{math.MinInt, math.MaxInt, math.MinInt + 1, math.MaxInt - 1, math.MinInt, math.MaxInt}, r),
+	}
+}
+
+func TestFiltersAgainstOracle(t *testing.T) {
+	for _, filter := range Filters() {
+		info := filter.Info()
+		for name, input := range edgeCases(info.Range) {
+			t.Run(info.Name+"/"+name, func(t *testing.T) {
+				checkFilter(t, filter, input)
+			})
+		}
+	}
+}
+
+func TestFiltersRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, filter := range Filters() {
+		info := filter.Info()
+		t.Run(info.Name, func(t *testing.T) {
+			for _, size := range []int{10, 100, 1000} {
+				for _, randMax := range []int{size / 2, size * 10, 1 << 20, math.MaxInt32} {
+					input := make([]int, size)
+					for i := range input {
+						input[i] = rng.Intn(2*randMax+1) - randMax
+					}
+					checkFilter(t, filter, fitInput(input, info.Range))
+				}
+			}
+		})
+	}
+}
+
+func TestFiltersLargeCardinality(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, filter := range Filters() {
+		info := filter.Info()
+		numDistinct := 1 << 18
+		if info.Time == TimeQuadratic {
+			numDistinct = 1 << 12
+		}
+		if testing.Short() {
+			numDistinct /= 16
+		}
+		t.Run(info.Name, func(t *testing.T) {
+			// Every value shows up twice, spread over several 65536-wide
+			// blocks and both signs.
+			input := make([]int, 0, 2*numDistinct)
+			for i := 0; i < numDistinct; i++ {
+				value := i * 37
+				if i%2 == 1 {
+					value = -value
+				}
+				input = append(input, value)
+			}
+			input = append(input, input...)
+			rng.Shuffle(len(input), func(i, j int) { input[i], input[j] = input[j], input[i] })
+			checkFilter(t, filter, fitInput(input, info.Range))
+		})
+	}
+}
+
+// decodeFuzzInput turns the fuzzer bytes into ints. The low bit of mode
+// narrows the values to a few multiples of 65536 around zero, which is where
+// the modulo-based hashing used to collide, and the second bit makes every
+// value 32 bits wide.
+func decodeFuzzInput(data []byte, mode uint8) []int {
+	input := make([]int, 0, len(data)/8)
+	for ; len(data) >= 8; data = data[8:] {
+		value := int(binary.LittleEndian.Uint64(data))
+		if mode&1 != 0 {
+			value = int(int8(value)) * 65536
+			if value%3 == 0 {
+				value += 5
+			}
+		}
+		if mode&2 != 0 {
+			value = int(int32(value))
+		}
+		input = append(input, value)
+	}
+	return input
+}
+
+// fuzzSpanRange is the range of the fuzz inputs of the MemorySpan filters.
+var fuzzSpanRange = ValueRange{Min: -1 << 24, Max: 1<<24 - 1}
+
+func FuzzFilters(f *testing.F) {
+	seed := func(mode uint8, values ...int) {
+		data := make([]byte, 0, 8*len(values))
+		for _, value := range values {
+			data = binary.LittleEndian.AppendUint64(data, uint64(value))
+		}
+		f.Add(data, mode)
+	}
+	seed(0)
+	seed(0, 16, 17, 2, 17, 4, 2, 97, 4, 17, 56)
+	seed(0, 5, 65541, -5, -65541, 5)
+	seed(0, math.MinInt, math.MaxInt, 0, math.MinInt, -1)
+	seed(1, 1, 2, 3, 1, 2, 3, -1)
+	seed(2, math.MinInt32, math.MaxInt32, 1<<32, math.MinInt32)
+
+	filters := Filters()
+	f.Fuzz(func(t *testing.T, data []byte, mode uint8) {
+		input := decodeFuzzInput(data, mode)
+		for _, filter := range filters {
+			info := filter.Info()
+			valueRange := info.Range
+			if info.Memory == MemorySpan {
+				// Keep the bitmap of the span filters small enough for the
+				// fuzzer to run fast; the table cases cover their full range.
+				valueRange = fuzzSpanRange
+			}
+			checkFilter(t, filter, fitInput(input, valueRange))
+		}
+	})
+}
+
+func FuzzGenericFilters(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 255, 255, 1, 0, 0, 0, 255, 255})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		narrow := make([]uint16, 0, len(data)/2)
+		for i := 0; i+1 < len(data); i += 2 {
+			narrow = append(narrow, binary.LittleEndian.Uint16(data[i:]))
+		}
+		want := FilterUniqueHashTable(narrow)
+		for name, fn := range map[string]func([]uint16) []uint16{
+			"Naive":            FilterUniqueNaive[uint16],
+			"BitHashTable":     FilterUniqueBitHashTable[uint16],
+			"DynamicHashTable": FilterUniqueDynamicHashTable[uint16],
+		} {
+			if got := fn(narrow); !slices.Equal(got, want) {
+				t.Errorf("%s(%v) = %v, want %v", name, narrow, got, want)
+			}
+		}
+
+		wide := make([]int32, 0, len(data)/4)
+		for i := 0; i+3 < len(data); i += 4 {
+			wide = append(wide, int32(binary.LittleEndian.Uint32(data[i:])))
+		}
+		wantWide := FilterUniqueHashTable(wide)
+		if got := FilterUniqueBitHashTable(wide); !slices.Equal(got, wantWide) {
+			t.Errorf("BitHashTable(%v) = %v, want %v", wide, got, wantWide)
+		}
+		if got := FilterUniqueDynamicHashTable(wide); !slices.Equal(got, wantWide) {
+			t.Errorf("DynamicHashTable(%v) = %v, want %v", wide, got, wantWide)
+		}
+	})
+}
@@ -0,0 +1,245 @@
+/*
+Author: Junior ADI
+Description: Input generators for the unique integers filters
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import (
+	"math/rand/v2"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+)
+
+// checkArray returns a *generator.ParamError if arr is nil or numElems is
+// less than 1.
+func checkArray(arr []int, numElems int) error {
+	if arr == nil {
+		return &generator.ParamError{Param: "array", Value: arr, Reason: "nil"}
+	}
+	if numElems < 1 {
+		return &generator.ParamError{Param: "number of elements", Value: numElems, Reason: "less than 1"}
+	}
+	return nil
+}
+
+// checkRandom returns a *generator.ParamError if r or arr is nil, numElems
+// is less than 1 or randMax is less than 1.
+func checkRandom(r *rand.Rand, arr []int, numElems, randMax int) error {
+	if r == nil {
+		return &generator.ParamError{Param: "random source", Value: r, Reason: "nil"}
+	}
+	if err := checkArray(arr, numElems); err != nil {
+		return err
+	}
+	if randMax < 1 {
+		return &generator.ParamError{Param: "randMax", Value: randMax, Reason: "less than 1"}
+	}
+	return nil
+}
+
+// checkDuplicates returns a *generator.ParamError if numDuplicates is less
+// than 1.
+func checkDuplicates(numDuplicates int) error {
+	if numDuplicates < 1 {
+		return &generator.ParamError{Param: "numDuplicates", Value: numDuplicates, Reason: "less than 1"}
+	}
+	return nil
+}
+
+// GenerateRandomInputArr fills arr with numElems random integers in the
+// range (-randMax, randMax), drawn from r. The same seeded source gives the
+// same array. It returns a *generator.ParamError if the source or the
+// array is nil, numElems is less than 1, or randMax is less than 1.
+func GenerateRandomInputArr(r *rand.Rand, arr []int, numElems, randMax int) error {
+	if err := checkRandom(r, arr, numElems, randMax); err != nil {
+		return err
+	}
+
+	for i := 0; i < numElems; i++ {
+		signFlag := r.IntN(2)
+		randNum := r.IntN(randMax)
+		if signFlag%2 == 0 {
+			arr[i] = randNum
+		} else {
+			arr[i] = -randNum
+		}
+	}
+	return nil
+}
+
+// GenerateGrowingArr fills arr with the numElems integers 0, 1, 2, ...
+// This is the best case for the improved algorithm. It returns a
+// *generator.ParamError if the array is nil or numElems is less than 1.
+func GenerateGrowingArr(arr []int, numElems int) error {
+	if err := checkArray(arr, numElems); err != nil {
+		return err
+	}
+
+	for i := 0; i < numElems; i++ {
+		arr[i] = i
+	}
+	return nil
+}
+
+// GenerateRandomInputArrImproved generates a random array of integers with specified number of elements and maximum random value. Numbers can be positive or negative, and there can be duplicates.
+//
+// Parameters:
+//   - r: the random source, seeded to reproduce the array
+//   - arr: the array to generate random integers in
+//   - numElems: the number of elements in the array
+//   - randMax: the maximum value for random integers
+//
+// Returns:
+//
+//	a *generator.ParamError if the source or the array is nil, numElems is less than 1, or randMax is less than 1
+//
+// Example:
+//
+//	arr := make([]int, 10)
+//	err := GenerateRandomInputArrImproved(rand.New(rand.NewPCG(1, 2)), arr, 10, 100)
+//	if err != nil {
+//	    fmt.Println(err)
+//	} else {
+//	    fmt.Println(arr)
+//	}
+func GenerateRandomInputArrImproved(r *rand.Rand, arr []int, numElems, randMax int) error {
+	if err := checkRandom(r, arr, numElems, randMax); err != nil {
+		return err
+	}
+
+	for i := 0; i < numElems; i++ {
+		signFlag := r.IntN(2)
+		randNum := r.IntN(randMax)
+		if signFlag%2 == 0 {
+			arr[i] = randNum
+		} else {
+			arr[i] = -randNum
+		}
+	}
+	return nil
+}
+
+// GenerateGrowingArrImproved generates a growing array of integers with specified number of elements. It fills a given array with increasing numbers,
+// starting with 0 and increasing by 1 each time.
+//
+// Parameters:
+//   - arr: the array to generate growing integers in
+//   - numElems: the number of elements in the array
+//
+// Returns:
+//
+//	a *generator.ParamError if the array is nil or numElems is less than 1
+//
+// Example:
+//
+//	arr := make([]int, 10)
+//	err := GenerateGrowingArrImproved(arr, 10)
+//	if err != nil {
+//	    fmt.Println(err)
+//	} else {
+//	    fmt.Println(arr)
+//	}
+func GenerateGrowingArrImproved(arr []int, numElems int) error {
+	if err := checkArray(arr, numElems); err != nil {
+		return err
+	}
+
+	// Modulo operation allows repetition of elements. A single element
+	// has no half to repeat.
+	period := max(numElems/2, 1)
+	for i := 0; i < numElems; i++ {
+		arr[i] = i % period
+	}
+	return nil
+}
+
+// GenerateRandomInputArrImproved2 generates a random array of integers with specified number of elements, maximum random value, and number of duplicates.
+// The values are drawn from a range numDuplicates times smaller, so the
+// actual number of duplicates is not controlled: see generator.Cardinality
+// for arrays with an exact number of distinct values.
+//
+// Parameters:
+//   - r: the random source, seeded to reproduce the array
+//   - arr: the array to generate random integers in
+//   - numElems: the number of elements in the array
+//   - randMax: the maximum value for random integers
+//   - numDuplicates: the number of duplicates for each random integer
+//
+// Returns:
+//
+//	a *generator.ParamError if the source or the array is nil, numElems is less than 1, randMax is less than 1, or numDuplicates is less than 1
+//
+// Example:
+//
+//	arr := make([]int, 10)
+//	err := GenerateRandomInputArrImproved2(rand.New(rand.NewPCG(1, 2)), arr, 10, 100, 5)
+//	if err != nil {
+//	    fmt.Println(err)
+//	} else {
+//	    fmt.Println(arr)
+//	}
+func GenerateRandomInputArrImproved2(r *rand.Rand, arr []int, numElems, randMax, numDuplicates int) error {
+	if err := checkRandom(r, arr, numElems, randMax); err != nil {
+		return err
+	}
+	if err := checkDuplicates(numDuplicates); err != nil {
+		return err
+	}
+
+	// The range shrinks by numDuplicates, down to the single value 0.
+	reducedMax := max(randMax/numDuplicates, 1)
+	for i := 0; i < numElems; i++ {
+		signFlag := r.IntN(2)
+		randNum := r.IntN(reducedMax)
+		if signFlag%2 == 0 {
+			arr[i] = randNum
+		} else {
+			arr[i] = -randNum
+		}
+	}
+	return nil
+}
+
+// GenerateGrowingArrImproved2 generates a growing array of integers with specified number of elements and number of duplicates.
+//
+// Parameters:
+//   - arr: the array to generate growing integers in
+//   - numElems: the number of elements in the array
+//   - numDuplicates: the number of duplicates for each growing integer
+//
+// Returns:
+//
+//	a *generator.ParamError if the array is nil, numElems is less than 1, or numDuplicates is less than 1
+//
+// Example:
+//
+//	arr := make([]int, 10)
+//	err := GenerateGrowingArrImproved2(arr, 10, 5)
+//	if err != nil {
+//	    fmt.Println(err)
+//	} else {
+//	    fmt.Println(arr)
+//	}
+func GenerateGrowingArrImproved2(arr []int, numElems, numDuplicates int) error {
+	if err := checkArray(arr, numElems); err != nil {
+		return err
+	}
+	if err := checkDuplicates(numDuplicates); err != nil {
+		return err
+	}
+
+	period := max(numElems/numDuplicates, 1)
+	for i := 0; i < numElems; i++ {
+		arr[i] = i % period
+	}
+	return nil
+}
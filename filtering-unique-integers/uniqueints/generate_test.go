@@ -0,0 +1,83 @@
+package uniqueints
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/junior-adi/Algorithmic/filtering-unique-integers/generator"
+)
+
+func TestGenerateRandomReproducible(t *testing.T) {
+	generators := map[string]func(r *rand.Rand, arr []int) error{
+		"GenerateRandomInputArr": func(r *rand.Rand, arr []int) error {
+			return GenerateRandomInputArr(r, arr, len(arr), 1000)
+		},
+		"GenerateRandomInputArrImproved": func(r *rand.Rand, arr []int) error {
+			return GenerateRandomInputArrImproved(r, arr, len(arr), 1000)
+		},
+		"GenerateRandomInputArrImproved2": func(r *rand.Rand, arr []int) error {
+			return GenerateRandomInputArrImproved2(r, arr, len(arr), 1000, 5)
+		},
+	}
+	for name, generate := range generators {
+		a, b, c := make([]int, 100), make([]int, 100), make([]int, 100)
+		for _, err := range []error{
+			generate(rand.New(rand.NewPCG(1, 2)), a),
+			generate(rand.New(rand.NewPCG(1, 2)), b),
+			generate(rand.New(rand.NewPCG(3, 4)), c),
+		} {
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+		if !slices.Equal(a, b) {
+			t.Errorf("%s: the same source generated two different arrays", name)
+		}
+		if slices.Equal(a, c) {
+			t.Errorf("%s: two sources generated the same array", name)
+		}
+		if err := generate(nil, a); err == nil {
+			t.Errorf("%s: nil source accepted", name)
+		}
+	}
+}
+
+func TestGenerateDuplicatesEdgeCases(t *testing.T) {
+	one := make([]int, 1)
+	if err := GenerateGrowingArrImproved(one, 1); err != nil || one[0] != 0 {
+		t.Errorf("GenerateGrowingArrImproved of 1 element = %v, %v", one, err)
+	}
+	arr := make([]int, 10)
+	if err := GenerateGrowingArrImproved2(arr, 10, 20); err != nil || slices.Max(arr) != 0 {
+		t.Errorf("GenerateGrowingArrImproved2 with more duplicates than elements = %v, %v", arr, err)
+	}
+	if err := GenerateRandomInputArrImproved2(rand.New(rand.NewPCG(1, 2)), arr, 10, 5, 20); err != nil || slices.Max(arr) != 0 || slices.Min(arr) != 0 {
+		t.Errorf("GenerateRandomInputArrImproved2 with more duplicates than randMax = %v, %v", arr, err)
+	}
+}
+
+func TestGenerateParamErrors(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	arr := make([]int, 10)
+	for _, test := range []struct {
+		name  string
+		err   error
+		param string
+	}{
+		{"GenerateRandomInputArr", GenerateRandomInputArr(nil, arr, 10, 100), "random source"},
+		{"GenerateRandomInputArr", GenerateRandomInputArr(r, nil, 10, 100), "array"},
+		{"GenerateRandomInputArrImproved", GenerateRandomInputArrImproved(r, arr, 0, 100), "number of elements"},
+		{"GenerateRandomInputArrImproved2", GenerateRandomInputArrImproved2(r, arr, 10, 0, 5), "randMax"},
+		{"GenerateRandomInputArrImproved2", GenerateRandomInputArrImproved2(r, arr, 10, 100, 0), "numDuplicates"},
+		{"GenerateGrowingArr", GenerateGrowingArr(nil, 10), "array"},
+		{"GenerateGrowingArrImproved", GenerateGrowingArrImproved(arr, -1), "number of elements"},
+		{"GenerateGrowingArrImproved2", GenerateGrowingArrImproved2(arr, 10, 0), "numDuplicates"},
+	} {
+		var paramErr *generator.ParamError
+		if !errors.As(test.err, &paramErr) || paramErr.Param != test.param {
+			t.Errorf("%s: error %v, want a *ParamError of the %s", test.name, test.err, test.param)
+		}
+	}
+}
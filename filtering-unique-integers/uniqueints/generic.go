@@ -0,0 +1,197 @@
+/*
+Author: Junior ADI
+Description: Generic filters for every integer width
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import "unsafe"
+
+// Integer is the set of the integer types accepted by the bitmap and hash
+// table filters.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer32 is the set of the integer types whose every value is in the
+// int32 range, taken by the generic versions of the ports of the C filters.
+type Integer32 interface {
+	~int8 | ~int16 | ~int32 | ~uint8 | ~uint16
+}
+
+// FilterUniqueNaive filters the unique values from a given slice in the
+// brute/naive way, like FilterUniqueElements.
+func FilterUniqueNaive[T comparable](input []T) []T {
+	var output []T
+	for _, elem := range input {
+		found := false
+		for _, val := range output {
+			if val == elem {
+				found = true
+				break
+			}
+		}
+		if !found {
+			output = append(output, elem)
+		}
+	}
+	return output
+}
+
+// FilterUniqueHashTable filters the unique values from a given slice using
+// the built-in Go map as the hash table, like FilterUniqueElementsHashTable.
+func FilterUniqueHashTable[T comparable](input []T) []T {
+	seen := make(map[T]bool)
+	var output []T
+
+	for _, elem := range input {
+		if !seen[elem] {
+			seen[elem] = true
+			output = append(output, elem)
+		}
+	}
+	return output
+}
+
+// magnitudeOf splits elem into its sign and its absolute value. The
+// magnitude of the smallest int64 is 1<<63, which still fits in a uint64.
+func magnitudeOf[T Integer](elem T) (bool, uint64) {
+	if elem < 0 {
+		return true, -uint64(int64(elem))
+	}
+	return false, uint64(elem)
+}
+
+// isNarrow reports whether T is at most 16 bits wide, in which case every
+// value of T fits in a direct bitmap of 65536 bits.
+func isNarrow[T Integer]() bool {
+	var zero T
+	return unsafe.Sizeof(zero) <= 2
+}
+
+// filterUniqueDirectBitmap filters the unique values of a slice of a narrow
+// type with a fixed-size bitmap of 65536 bits indexed by the value itself.
+func filterUniqueDirectBitmap[T Integer](input []T) []T {
+	bitmap := make([]uint8, 1<<16/8)
+
+	var output []T
+
+	for _, elem := range input {
+		bitPosition := int(uint16(elem))
+		if checkBit(bitmap, bitPosition) {
+			continue
+		}
+		output = append(output, elem)
+		flipBit(bitmap, bitPosition)
+	}
+
+	return output
+}
+
+// FilterUniqueBitHashTable filters the unique values from a given slice
+// using the multi-level bitmap of FilterUniqueElementsBitHashTable. Types of
+// 16 bits or less use a fixed-size direct bitmap instead.
+func FilterUniqueBitHashTable[T Integer](input []T) []T {
+	if isNarrow[T]() {
+		return filterUniqueDirectBitmap(input)
+	}
+
+	hashTable := newBitHashTable()
+
+	var output []T
+
+	for _, elem := range input {
+		if hashTable.testAndSet(magnitudeOf(elem)) {
+			output = append(output, elem)
+		}
+	}
+
+	return output
+}
+
+// FilterUniqueDynamicHashTable filters the unique values from a given slice
+// using the lazily allocated pages of FilterUniqueElementsDynamicHashTable.
+// Types of 16 bits or less use a fixed-size direct bitmap instead.
+func FilterUniqueDynamicHashTable[T Integer](input []T) []T {
+	if isNarrow[T]() {
+		return filterUniqueDirectBitmap(input)
+	}
+
+	output, _ := filterUniqueDynamicHashTable(input)
+	return output
+}
+
+// filterUniqueDynamicHashTable is the common implementation of the dynamic
+// hash table filters.
+func filterUniqueDynamicHashTable[T Integer](input []T) ([]T, HashTableStats) {
+	hashTable := newDynamicHashTable()
+
+	var output []T
+
+	for _, elem := range input {
+		if hashTable.testAndSet(magnitudeOf(elem)) {
+			output = append(output, elem)
+		}
+	}
+
+	return output, hashTable.stats
+}
+
+// FilterUniqueHT is the generic port of filter_unique_elems_ht.
+func FilterUniqueHT[T Integer32](input []T) []T {
+	return filterUniqueHT(input)
+}
+
+// FilterUniqueHTNew is the generic port of filter_unique_elems_ht_new.
+func FilterUniqueHTNew[T Integer32](input []T) []T {
+	return filterUniqueHTNew(input)
+}
+
+// FilterUniqueHTDyn is the generic port of filter_unique_elems_ht_dyn.
+func FilterUniqueHTDyn[T Integer32](input []T) []T {
+	return filterUniqueHTDyn(input)
+}
+
+// FilterUniqueBitmapStatic is the generic port of fui_bitmap_stc.
+func FilterUniqueBitmapStatic[T Integer32](input []T) []T {
+	return filterUniqueBitmapStatic(input)
+}
+
+// FilterUniqueBitmapBaseDynamic is the generic port of fui_bitmap_base_dyn.
+func FilterUniqueBitmapBaseDynamic[T Integer32](input []T) []T {
+	return filterUniqueBitmapBaseDynamic(input)
+}
+
+// FilterUniqueBitmapFullDynamic is the generic port of fui_bitmap_full_dyn.
+func FilterUniqueBitmapFullDynamic[T Integer32](input []T) []T {
+	return filterUniqueBitmapFullDynamic(input)
+}
+
+// FilterUniqueBitmapDbase is the generic port of fui_bitmap_dbase.
+func FilterUniqueBitmapDbase[T Integer32](input []T) []T {
+	return filterUniqueBitmapDbase(input, false)
+}
+
+// FilterUniqueBitmapDbaseDynamic is the generic port of fui_bitmap_dbase_dyn.
+func FilterUniqueBitmapDbaseDynamic[T Integer32](input []T) []T {
+	return filterUniqueBitmapDbase(input, true)
+}
+
+// FilterUniqueBitmapArray is the generic port of fui_bitmap_array.
+func FilterUniqueBitmapArray[T Integer32](input []T) []T {
+	return filterUniqueBitmapArray(input)
+}
+
+// FilterUniqueBitmapArrayDynamic is the generic port of fui_bitmap_array_dyn.
+func FilterUniqueBitmapArrayDynamic[T Integer32](input []T) []T {
+	return filterUniqueBitmapArrayDynamic(input)
+}
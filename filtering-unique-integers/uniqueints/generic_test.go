@@ -0,0 +1,63 @@
+package uniqueints
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func testGenericFilters[T Integer](t *testing.T, name string, input []T) {
+	t.Helper()
+	want := FilterUniqueHashTable(input)
+	filters := []struct {
+		name string
+		fn   func([]T) []T
+	}{
+		{"Naive", FilterUniqueNaive[T]},
+		{"BitHashTable", FilterUniqueBitHashTable[T]},
+		{"DynamicHashTable", FilterUniqueDynamicHashTable[T]},
+	}
+	for _, filter := range filters {
+		if got := filter.fn(input); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s/%s(%v) = %v, want %v", name, filter.name, input, got, want)
+		}
+	}
+}
+
+func testInt32Ports[T Integer32](t *testing.T, name string, input []T) {
+	t.Helper()
+	testGenericFilters(t, name, input)
+	want := FilterUniqueHashTable(input)
+	filters := []struct {
+		name string
+		fn   func([]T) []T
+	}{
+		{"HT", FilterUniqueHT[T]},
+		{"HTNew", FilterUniqueHTNew[T]},
+		{"HTDyn", FilterUniqueHTDyn[T]},
+		{"BitmapStatic", FilterUniqueBitmapStatic[T]},
+		{"BitmapBaseDynamic", FilterUniqueBitmapBaseDynamic[T]},
+		{"BitmapFullDynamic", FilterUniqueBitmapFullDynamic[T]},
+		{"BitmapDbase", FilterUniqueBitmapDbase[T]},
+		{"BitmapDbaseDynamic", FilterUniqueBitmapDbaseDynamic[T]},
+		{"BitmapArray", FilterUniqueBitmapArray[T]},
+		{"BitmapArrayDynamic", FilterUniqueBitmapArrayDynamic[T]},
+	}
+	for _, filter := range filters {
+		if got := filter.fn(input); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s/%s(%v) = %v, want %v", name, filter.name, input, got, want)
+		}
+	}
+}
+
+func TestGenericFilters(t *testing.T) {
+	testInt32Ports(t, "int8", []int8{math.MinInt8, -1, 0, 1, math.MaxInt8, -1, math.MinInt8})
+	testInt32Ports(t, "uint8", []uint8{0, 255, 1, 255, 0})
+	testInt32Ports(t, "int16", []int16{math.MinInt16, math.MaxInt16, -1, 1, math.MinInt16})
+	testInt32Ports(t, "uint16", []uint16{0, math.MaxUint16, 1, 65535, 0})
+	testInt32Ports(t, "int32", []int32{math.MinInt32, math.MaxInt32, 5, 65541, -5, -65541, 5})
+	testGenericFilters(t, "uint32", []uint32{math.MaxUint32, 0, 65536, 1 << 31, 65536})
+	testGenericFilters(t, "int64", []int64{math.MinInt64, math.MaxInt64, 1 << 40, -(1 << 40), math.MinInt64})
+	testGenericFilters(t, "uint64", []uint64{math.MaxUint64, 1 << 63, 0, 1<<63 + 65536, math.MaxUint64})
+	testGenericFilters(t, "uintptr", []uintptr{0, 1 << 20, 1 << 20})
+}
@@ -0,0 +1,136 @@
+/*
+Author: Junior ADI
+Description: Filtering unique integers with a dynamic hash table
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import "unsafe"
+
+func init() {
+	registerFunc(Info{
+		Name:            "DynamicHashTable",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsDynamicHashTable)
+}
+
+// hashTableBaseNode is a node of the hash table ports of the C filters, with
+// a branch of int flags for each sign.
+type hashTableBaseNode struct {
+	branchSizeP uint32
+	branchSizeN uint32
+	ptrBranchP  []int
+	ptrBranchN  []int
+}
+
+// dynPageBits is the number of low bits of a magnitude addressed inside a
+// page of the dynamic hash table. The remaining high bits select the page.
+const dynPageBits = 6
+
+// HashTableStats describes the memory used by a hash table filter.
+type HashTableStats struct {
+	// Pages is the number of pages allocated.
+	Pages int
+	// BytesAllocated is the number of bytes allocated for the pages: their
+	// headers and their branches of flags, including the branches later
+	// replaced by larger ones. It leaves out the map indexing the pages,
+	// whose overhead depends on the Go runtime.
+	BytesAllocated int
+}
+
+// dynamicHashTablePage is a page of the dynamic hash table, with a branch of
+// byte flags for each sign.
+type dynamicHashTablePage struct {
+	ptrBranchP []uint8
+	ptrBranchN []uint8
+}
+
+// dynamicHashTable is a hash table of small pages keyed by the high bits of
+// the magnitude of a value. A page is only allocated when a value of its
+// range is seen, and each branch of a page only grows up to the largest low
+// bits seen so far, so the memory grows with the number of distinct values.
+type dynamicHashTable struct {
+	pages map[uint64]*dynamicHashTablePage
+	stats HashTableStats
+}
+
+func newDynamicHashTable() *dynamicHashTable {
+	return &dynamicHashTable{
+		pages: make(map[uint64]*dynamicHashTablePage),
+	}
+}
+
+// growBranch returns branch extended so that modIndex is a valid index,
+// doubling its size and capping it to the page size.
+func (t *dynamicHashTable) growBranch(branch []uint8, modIndex uint32) []uint8 {
+	if int(modIndex) < len(branch) {
+		return branch
+	}
+	newSize := 2 * len(branch)
+	if newSize < int(modIndex)+1 {
+		newSize = int(modIndex) + 1
+	}
+	if newSize > 1<<dynPageBits {
+		newSize = 1 << dynPageBits
+	}
+	grown := make([]uint8, newSize)
+	copy(grown, branch)
+	t.stats.BytesAllocated += newSize
+	return grown
+}
+
+// testAndSet marks the value given by its sign and magnitude as seen and
+// reports whether it was seen for the first time.
+func (t *dynamicHashTable) testAndSet(negative bool, magnitude uint64) bool {
+	pageKey := magnitude >> dynPageBits
+	page := t.pages[pageKey]
+	if page == nil {
+		page = &dynamicHashTablePage{}
+		t.pages[pageKey] = page
+		t.stats.Pages++
+		t.stats.BytesAllocated += int(unsafe.Sizeof(*page))
+	}
+
+	modIndex := uint32(magnitude & (1<<dynPageBits - 1))
+	var ptrBranch []uint8
+	if negative {
+		page.ptrBranchN = t.growBranch(page.ptrBranchN, modIndex)
+		ptrBranch = page.ptrBranchN
+	} else {
+		page.ptrBranchP = t.growBranch(page.ptrBranchP, modIndex)
+		ptrBranch = page.ptrBranchP
+	}
+
+	if ptrBranch[modIndex] != 0 {
+		return false
+	}
+	ptrBranch[modIndex] = 1
+	return true
+}
+
+// FilterUniqueElementsDynamicHashTable filters the unique integers from a
+// given array using a hash table whose pages are allocated lazily and keyed
+// by the high bits of each value.
+func FilterUniqueElementsDynamicHashTable(input []int) []int {
+	output, _ := FilterUniqueElementsDynamicHashTableWithStats(input)
+	return output
+}
+
+// FilterUniqueElementsDynamicHashTableWithStats works like
+// FilterUniqueElementsDynamicHashTable and also reports the memory allocated
+// by the hash table.
+func FilterUniqueElementsDynamicHashTableWithStats(input []int) ([]int, HashTableStats) {
+	return filterUniqueDynamicHashTable(input)
+}
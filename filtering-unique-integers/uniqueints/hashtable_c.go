@@ -0,0 +1,210 @@
+/*
+Author: Junior ADI
+Description: Go ports of the hash table filters of unique-integers-filter.c
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import "unsafe"
+
+// Constants of the hash table filters, as defined in unique-integers-filter.h.
+//
+// The 32-bit signed integers range from -32768 x 65536 to 32768 x 65536, so
+// for any int32 x, |x / 65536| <= 32768 and |x % 65536| < 65536. The hash
+// table therefore needs 32769 entries and each branch 65536 entries.
+const (
+	hashTableSize = 32769
+	modTableSize  = 65536
+	htDynIniSize  = 32
+)
+
+// htBlockBytes is the size of a branch of int flags, allocated for each
+// block of values touched by the input.
+const htBlockBytes = modTableSize * int(unsafe.Sizeof(int(0)))
+
+func init() {
+	registerFunc(Info{
+		Name:            "HT",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      htBlockBytes,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsHT))
+	registerFunc(Info{
+		Name:            "HTNew",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      htBlockBytes,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsHTNew))
+	registerFunc(Info{
+		Name:            "HTDyn",
+		OrderPreserving: true,
+		Range:           Int32Range,
+		Memory:          MemoryBlocks,
+		BlockBytes:      htBlockBytes,
+		Time:            TimeLinear,
+		ThreadSafe:      true,
+	}, mustInt32(FilterUniqueElementsHTDyn))
+}
+
+// hashTablePosition returns the quotient and the mod of tmp used to index
+// the C hash tables.
+func hashTablePosition(tmp int) (int, int) {
+	tmpQuotient := tmp / modTableSize
+	tmpMod := tmp % modTableSize
+	if tmpQuotient < 0 {
+		tmpQuotient = -tmpQuotient
+	}
+	if tmpMod < 0 {
+		tmpMod = -tmpMod
+	}
+	return tmpQuotient, tmpMod
+}
+
+// FilterUniqueElementsHT filters the unique integers from a given array
+// using the basic hash table algorithm: two tables of hashTableSize entries
+// for the positive and the other values, each entry being a branch of
+// modTableSize flags allocated on first use. It is the Go port of
+// filter_unique_elems_ht. The garbage collector releases the tables, which
+// replaces free_hash_table.
+func FilterUniqueElementsHT(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueHT(input), nil
+}
+
+// filterUniqueHT is the implementation of the basic hash table filters.
+func filterUniqueHT[T Integer](input []T) []T {
+	var hashTableBaseP [hashTableSize][]int
+	var hashTableBaseN [hashTableSize][]int
+
+	var output []T
+
+	for _, tmp := range input {
+		tmpQuotient, tmpMod := hashTablePosition(int(tmp))
+
+		hashTableBase := &hashTableBaseN
+		if tmp > 0 {
+			hashTableBase = &hashTableBaseP
+		}
+		if hashTableBase[tmpQuotient] == nil {
+			hashTableBase[tmpQuotient] = make([]int, modTableSize)
+		}
+		if hashTableBase[tmpQuotient][tmpMod] != 0 {
+			continue
+		}
+		output = append(output, tmp)
+		hashTableBase[tmpQuotient][tmpMod] = 1
+	}
+
+	return output
+}
+
+// reallocBranch returns branch extended to size entries, the new entries
+// being zero, like the realloc and memset of filter_unique_elems_ht_new.
+func reallocBranch(branch []int, size uint32) []int {
+	grown := make([]int, size)
+	copy(grown, branch)
+	return grown
+}
+
+// testAndSetC marks tmp as seen in the single base hash table node and
+// reports whether it was seen for the first time. A branch is allocated with
+// exactly tmpMod + 1 entries and reallocated when a larger mod shows up.
+func (node *hashTableBaseNode) testAndSetC(tmp, tmpMod int) bool {
+	size := uint32(tmpMod + 1)
+	var ptrBranch []int
+	if tmp > 0 {
+		if node.branchSizeP < size {
+			node.ptrBranchP = reallocBranch(node.ptrBranchP, size)
+			node.branchSizeP = size
+		}
+		ptrBranch = node.ptrBranchP
+	} else {
+		if node.branchSizeN < size {
+			node.ptrBranchN = reallocBranch(node.ptrBranchN, size)
+			node.branchSizeN = size
+		}
+		ptrBranch = node.ptrBranchN
+	}
+
+	if ptrBranch[tmpMod] != 0 {
+		return false
+	}
+	ptrBranch[tmpMod] = 1
+	return true
+}
+
+// FilterUniqueElementsHTNew filters the unique integers from a given array
+// using the single base hash table algorithm: one table of hashTableSize
+// nodes whose positive and negative branches only grow up to the largest mod
+// seen. It is the Go port of filter_unique_elems_ht_new. The garbage
+// collector releases the table, which replaces free_hash_table_new.
+func FilterUniqueElementsHTNew(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueHTNew(input), nil
+}
+
+// filterUniqueHTNew is the implementation of the single base hash table
+// filters.
+func filterUniqueHTNew[T Integer](input []T) []T {
+	var hashTableBase [hashTableSize]hashTableBaseNode
+
+	var output []T
+
+	for _, tmp := range input {
+		tmpQuotient, tmpMod := hashTablePosition(int(tmp))
+
+		if hashTableBase[tmpQuotient].testAndSetC(int(tmp), tmpMod) {
+			output = append(output, tmp)
+		}
+	}
+
+	return output
+}
+
+// FilterUniqueElementsHTDyn filters the unique integers from a given array
+// using the single base hash table algorithm with fully dynamic memory
+// allocation: the table starts with htDynIniSize nodes and grows to the
+// largest quotient seen. It is the Go port of filter_unique_elems_ht_dyn.
+func FilterUniqueElementsHTDyn(input []int) ([]int, error) {
+	if err := checkInt32(input); err != nil {
+		return nil, err
+	}
+	return filterUniqueHTDyn(input), nil
+}
+
+// filterUniqueHTDyn is the implementation of the dynamic single base hash
+// table filters.
+func filterUniqueHTDyn[T Integer](input []T) []T {
+	hashTableBase := make([]hashTableBaseNode, htDynIniSize)
+
+	var output []T
+
+	for _, tmp := range input {
+		tmpQuotient, tmpMod := hashTablePosition(int(tmp))
+
+		hashTableBase = growTable(hashTableBase, tmpQuotient)
+		if hashTableBase[tmpQuotient].testAndSetC(int(tmp), tmpMod) {
+			output = append(output, tmp)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,68 @@
+package uniqueints
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestHashTablePosition(t *testing.T) {
+	tests := []struct {
+		tmp, quotient, mod int
+	}{
+		{0, 0, 0},
+		{65535, 0, 65535},
+		{-65535, 0, 65535},
+		{65536, 1, 0},
+		{-65536, 1, 0},
+		{math.MaxInt32, 32767, 65535},
+		// The magnitude of math.MinInt32 is 32768 x 65536: the last entry.
+		{math.MinInt32, hashTableSize - 1, 0},
+	}
+	for _, tt := range tests {
+		if quotient, mod := hashTablePosition(tt.tmp); quotient != tt.quotient || mod != tt.mod {
+			t.Errorf("hashTablePosition(%d) = %d, %d, want %d, %d", tt.tmp, quotient, mod, tt.quotient, tt.mod)
+		}
+	}
+}
+
+func TestTestAndSetC(t *testing.T) {
+	var node hashTableBaseNode
+	if !node.testAndSetC(5, 5) || node.branchSizeP != 6 || node.branchSizeN != 0 {
+		t.Errorf("first 5: sizes %d and %d, want a positive branch of 6", node.branchSizeP, node.branchSizeN)
+	}
+	if node.testAndSetC(5, 5) {
+		t.Errorf("second 5 reported as new")
+	}
+	// 0 goes to the negative branch, like in filter_unique_elems_ht_new.
+	if !node.testAndSetC(-5, 5) || !node.testAndSetC(0, 0) || node.branchSizeN != 6 {
+		t.Errorf("-5 and 0: negative branch of %d, want 6", node.branchSizeN)
+	}
+	// A larger mod reallocates the branch and keeps its flags.
+	if !node.testAndSetC(65535, 65535) || node.branchSizeP != modTableSize || node.testAndSetC(5, 5) {
+		t.Errorf("65535: positive branch of %d, want %d with 5 still set", node.branchSizeP, modTableSize)
+	}
+}
+
+func TestHashTableFiltersC(t *testing.T) {
+	tests := []struct {
+		name        string
+		input, want []int
+	}{
+		{"boundaries", []int{0, -65536, 65535, 65536, -65535, 0, -65536, 65535}, []int{0, -65536, 65535, 65536, -65535}},
+		{"signs", []int{5, -5, 0, 5, -5, 0}, []int{5, -5, 0}},
+		// HTDyn grows its table from htDynIniSize nodes to the last one.
+		{"int32 limits", []int{math.MinInt32, math.MaxInt32, -math.MaxInt32, math.MinInt32, math.MaxInt32}, []int{math.MinInt32, math.MaxInt32, -math.MaxInt32}},
+	}
+	for name, fn := range map[string]func([]int) ([]int, error){
+		"HT":    FilterUniqueElementsHT,
+		"HTNew": FilterUniqueElementsHTNew,
+		"HTDyn": FilterUniqueElementsHTDyn,
+	} {
+		for _, tt := range tests {
+			if got, err := fn(tt.input); err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s/%s: got %v, %v, want %v", name, tt.name, got, err, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package uniqueints
+
+import (
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+func TestDynamicHashTablePages(t *testing.T) {
+	page := int(unsafe.Sizeof(dynamicHashTablePage{}))
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+		stats HashTableStats
+	}{
+		{"empty", nil, nil, HashTableStats{}},
+		{"single", []int{0, 0}, []int{0}, HashTableStats{Pages: 1, BytesAllocated: page + 1}},
+		// A branch doubles, or grows to the largest index seen.
+		{"doubling", []int{0, 1, 2, 3, 2}, []int{0, 1, 2, 3}, HashTableStats{Pages: 1, BytesAllocated: page + 1 + 2 + 4}},
+		{"page end", []int{0, 63, 0}, []int{0, 63}, HashTableStats{Pages: 1, BytesAllocated: page + 1 + 64}},
+		// Both signs of a magnitude share a page.
+		{"signs", []int{5, -5, 5}, []int{5, -5}, HashTableStats{Pages: 1, BytesAllocated: page + 6 + 6}},
+		// A page covers 64 magnitudes, and is only allocated when needed.
+		{"pages", []int{0, 64, 1 << 40, 64}, []int{0, 64, 1 << 40}, HashTableStats{Pages: 3, BytesAllocated: 3*page + 3}},
+	}
+	for _, tt := range tests {
+		got, stats := FilterUniqueElementsDynamicHashTableWithStats(tt.input)
+		if !slices.Equal(got, tt.want) || stats != tt.stats {
+			t.Errorf("%s: FilterUniqueElementsDynamicHashTableWithStats(%v) = %v, %+v, want %v, %+v", tt.name, tt.input, got, stats, tt.want, tt.stats)
+		}
+	}
+}
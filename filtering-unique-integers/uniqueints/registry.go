@@ -0,0 +1,196 @@
+/*
+Author: Junior ADI
+Description: Registry of the unique integers filters
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Original repository: https://github.com/zhenrong-wang/filter-uniq-ints.git
+
+This code is licensed under the MIT License.
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// ValueRange is the closed range of the values a filter supports.
+type ValueRange struct {
+	Min int
+	Max int
+}
+
+var (
+	// FullRange covers every int value.
+	FullRange = ValueRange{Min: math.MinInt, Max: math.MaxInt}
+	// Int32Range covers the values of the ports of the C filters, which work
+	// on 32-bit C ints.
+	Int32Range = ValueRange{Min: math.MinInt32, Max: math.MaxInt32}
+)
+
+// Contains reports whether value is in the range.
+func (r ValueRange) Contains(value int) bool {
+	return r.Min <= value && value <= r.Max
+}
+
+// String returns the range as "[min, max]".
+func (r ValueRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.Min, r.Max)
+}
+
+// MemoryClass tells what the memory used by a filter grows with.
+type MemoryClass int
+
+const (
+	// MemoryDistinct grows with the number of distinct values.
+	MemoryDistinct MemoryClass = iota
+	// MemoryBlocks grows with the number of 65536-wide blocks of values
+	// touched by the input, on top of a fixed table of blocks.
+	MemoryBlocks
+	// MemorySpan grows with the difference between the max and min values.
+	MemorySpan
+)
+
+// String returns the name of the memory class.
+func (c MemoryClass) String() string {
+	switch c {
+	case MemoryDistinct:
+		return "distinct"
+	case MemoryBlocks:
+		return "blocks"
+	case MemorySpan:
+		return "span"
+	}
+	return fmt.Sprintf("MemoryClass(%d)", int(c))
+}
+
+// TimeClass is the worst-case time complexity of a filter.
+type TimeClass int
+
+const (
+	// TimeLinear is O(n).
+	TimeLinear TimeClass = iota
+	// TimeLinearithmic is O(n log n).
+	TimeLinearithmic
+	// TimeQuadratic is O(n²).
+	TimeQuadratic
+)
+
+// String returns the complexity in big O notation.
+func (c TimeClass) String() string {
+	switch c {
+	case TimeLinear:
+		return "O(n)"
+	case TimeLinearithmic:
+		return "O(n log n)"
+	case TimeQuadratic:
+		return "O(n²)"
+	}
+	return fmt.Sprintf("TimeClass(%d)", int(c))
+}
+
+// Info is the metadata of a filter.
+type Info struct {
+	// Name identifies the filter in the registry.
+	Name string
+	// OrderPreserving tells whether the output keeps the values in the order
+	// of their first occurrence in the input.
+	OrderPreserving bool
+	// Range is the range of the supported input values. A filter may panic
+	// on any value outside of it.
+	Range ValueRange
+	// Memory is what the memory used by the filter grows with.
+	Memory MemoryClass
+	// BlockBytes is, for a MemoryBlocks filter, the largest number of bytes
+	// allocated for each 65536-wide block of values touched by the input.
+	BlockBytes int
+	// Time is the worst-case time complexity of the filter.
+	Time TimeClass
+	// ThreadSafe tells whether the filter may be called from several
+	// goroutines at the same time.
+	ThreadSafe bool
+}
+
+// Filter is an algorithm filtering the unique integers from a given array.
+type Filter interface {
+	// Info returns the metadata of the filter.
+	Info() Info
+	// Filter returns the unique integers of input.
+	Filter(input []int) []int
+}
+
+type funcFilter struct {
+	info Info
+	fn   func([]int) []int
+}
+
+func (f funcFilter) Info() Info               { return f.info }
+func (f funcFilter) Filter(input []int) []int { return f.fn(input) }
+
+// NewFilter returns a Filter described by info and implemented by fn.
+func NewFilter(info Info, fn func([]int) []int) Filter {
+	return funcFilter{info: info, fn: fn}
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Filter)
+)
+
+// Register makes a filter available by its name. It panics if the name is
+// empty or already registered.
+func Register(filter Filter) {
+	name := filter.Info().Name
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if name == "" {
+		panic("uniqueints: Register filter with an empty name")
+	}
+	if _, dup := registry[name]; dup {
+		panic("uniqueints: Register called twice for filter " + name)
+	}
+	registry[name] = filter
+}
+
+// registerFunc registers fn as a filter described by info.
+func registerFunc(info Info, fn func([]int) []int) {
+	Register(NewFilter(info, fn))
+}
+
+// Lookup returns the filter registered with the given name.
+func Lookup(name string) (Filter, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	filter, ok := registry[name]
+	return filter, ok
+}
+
+// Filters returns the registered filters sorted by name.
+func Filters() []Filter {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	filters := make([]Filter, 0, len(registry))
+	for _, filter := range registry {
+		filters = append(filters, filter)
+	}
+	sort.Slice(filters, func(i, j int) bool {
+		return filters[i].Info().Name < filters[j].Info().Name
+	})
+	return filters
+}
+
+// Names returns the names of the registered filters, sorted.
+func Names() []string {
+	filters := Filters()
+	names := make([]string, len(filters))
+	for i, filter := range filters {
+		names[i] = filter.Info().Name
+	}
+	return names
+}
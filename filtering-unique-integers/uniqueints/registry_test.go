@@ -0,0 +1,36 @@
+package uniqueints
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	for _, name := range Names() {
+		filter, ok := Lookup(name)
+		if !ok {
+			t.Fatalf("Lookup(%q) failed", name)
+		}
+		if got := filter.Info().Name; got != name {
+			t.Errorf("Lookup(%q).Info().Name = %q", name, got)
+		}
+	}
+	if _, ok := Lookup("NoSuchAlgorithm"); ok {
+		t.Error(`Lookup("NoSuchAlgorithm") succeeded`)
+	}
+}
+
+func TestBlockBytes(t *testing.T) {
+	for _, filter := range Filters() {
+		info := filter.Info()
+		if (info.Memory == MemoryBlocks) != (info.BlockBytes > 0) {
+			t.Errorf("%s filter of memory class %v has BlockBytes %d", info.Name, info.Memory, info.BlockBytes)
+		}
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Register did not panic on a duplicate name")
+		}
+	}()
+	Register(NewFilter(Info{Name: "Naive"}, FilterUniqueElements))
+}
@@ -0,0 +1,192 @@
+/*
+Author: Junior ADI
+Description: Filtering unique integers with a binary search tree
+Date: April 8th 2024, 12:17 AM GMT
+Location: Abidjan, Cote d'Ivoire.
+
+Ported from newAlgoOfRemovingDuplicatesUsingBinaryTrees.c
+
+License GPLv3
+
+Copyright (c) 2024, Junior ADI
+*/
+
+package uniqueints
+
+import "context"
+
+func init() {
+	registerContextFunc(Info{
+		Name:            "BinaryTree",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeQuadratic,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsBinaryTreeContext)
+	registerContextFunc(Info{
+		Name:            "AVLTree",
+		OrderPreserving: true,
+		Range:           FullRange,
+		Memory:          MemoryDistinct,
+		Time:            TimeLinearithmic,
+		ThreadSafe:      true,
+	}, FilterUniqueElementsAVLTreeContext)
+}
+
+// treeNode is a node of the binary search tree of the tree filters, like
+// the Node of newAlgoOfRemovingDuplicatesUsingBinaryTrees.c.
+type treeNode struct {
+	data   int
+	height int
+	left   *treeNode
+	right  *treeNode
+}
+
+// insertNode inserts data in the unbalanced tree rooted at *root and reports
+// whether data was not in the tree yet, and the depth it was found or
+// inserted at. The insertion is iterative, so the degenerate tree built from
+// a sorted input cannot exhaust the stack.
+func insertNode(root **treeNode, data int) (bool, int) {
+	link := root
+	depth := 0
+	for *link != nil {
+		switch {
+		case data < (*link).data:
+			link = &(*link).left
+		case data > (*link).data:
+			link = &(*link).right
+		default:
+			return false, depth
+		}
+		depth++
+	}
+	*link = &treeNode{data: data, height: 1}
+	return true, depth
+}
+
+// FilterUniqueElementsBinaryTree filters the unique integers from a given
+// array by inserting every element in an unbalanced binary search tree. It
+// is O(n log n) on random inputs but degrades to O(n²) on sorted inputs,
+// where the tree becomes a linked list.
+func FilterUniqueElementsBinaryTree(input []int) []int {
+	output, _ := FilterUniqueElementsBinaryTreeContext(context.Background(), input)
+	return output
+}
+
+// FilterUniqueElementsBinaryTreeContext is FilterUniqueElementsBinaryTree
+// returning ctx.Err() once ctx is done.
+func FilterUniqueElementsBinaryTreeContext(ctx context.Context, input []int) ([]int, error) {
+	c := newCanceller(ctx)
+	var root *treeNode
+
+	var output []int
+
+	for _, elem := range input {
+		inserted, depth := insertNode(&root, elem)
+		if inserted {
+			output = append(output, elem)
+		}
+		if err := c.step(depth + 1); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func nodeHeight(node *treeNode) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func updateHeight(node *treeNode) {
+	node.height = 1 + max(nodeHeight(node.left), nodeHeight(node.right))
+}
+
+func rotateRight(node *treeNode) *treeNode {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	updateHeight(node)
+	updateHeight(pivot)
+	return pivot
+}
+
+func rotateLeft(node *treeNode) *treeNode {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	updateHeight(node)
+	updateHeight(pivot)
+	return pivot
+}
+
+// insertNodeAVL inserts data in the AVL tree rooted at node and returns the
+// new root of the subtree. inserted is set when data was not in the tree yet.
+func insertNodeAVL(node *treeNode, data int, inserted *bool) *treeNode {
+	if node == nil {
+		*inserted = true
+		return &treeNode{data: data, height: 1}
+	}
+
+	switch {
+	case data < node.data:
+		node.left = insertNodeAVL(node.left, data, inserted)
+	case data > node.data:
+		node.right = insertNodeAVL(node.right, data, inserted)
+	default:
+		return node
+	}
+	if !*inserted {
+		return node
+	}
+
+	updateHeight(node)
+	balance := nodeHeight(node.left) - nodeHeight(node.right)
+	switch {
+	case balance > 1 && data < node.left.data:
+		return rotateRight(node)
+	case balance > 1:
+		node.left = rotateLeft(node.left)
+		return rotateRight(node)
+	case balance < -1 && data > node.right.data:
+		return rotateLeft(node)
+	case balance < -1:
+		node.right = rotateRight(node.right)
+		return rotateLeft(node)
+	}
+	return node
+}
+
+// FilterUniqueElementsAVLTree filters the unique integers from a given array
+// by inserting every element in a self-balancing AVL tree, which keeps every
+// insertion O(log n) whatever the order of the input.
+func FilterUniqueElementsAVLTree(input []int) []int {
+	output, _ := FilterUniqueElementsAVLTreeContext(context.Background(), input)
+	return output
+}
+
+// FilterUniqueElementsAVLTreeContext is FilterUniqueElementsAVLTree
+// returning ctx.Err() once ctx is done.
+func FilterUniqueElementsAVLTreeContext(ctx context.Context, input []int) ([]int, error) {
+	c := newCanceller(ctx)
+	var root *treeNode
+
+	var output []int
+
+	for _, elem := range input {
+		inserted := false
+		root = insertNodeAVL(root, elem, &inserted)
+		if inserted {
+			output = append(output, elem)
+		}
+		if err := c.step(nodeHeight(root)); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
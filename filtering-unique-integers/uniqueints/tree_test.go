@@ -0,0 +1,64 @@
+package uniqueints
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestTreeFilters(t *testing.T) {
+	filters := []struct {
+		name string
+		fn   func([]int) []int
+	}{
+		{"BinaryTree", FilterUniqueElementsBinaryTree},
+		{"AVLTree", FilterUniqueElementsAVLTree},
+	}
+
+	random := make([]int, 10000)
+	rng := rand.New(rand.NewSource(1))
+	for i := range random {
+		random[i] = rng.Intn(2*len(random)) - len(random)
+	}
+	growing := make([]int, 2000)
+	if err := GenerateGrowingArrImproved(growing, len(growing)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"nil", nil},
+		{"sample", []int{1, 2, 3, 2, 4, 5, 1, 6}},
+		{"int edges", []int{math.MinInt, math.MaxInt, 0, math.MaxInt, math.MinInt}},
+		{"growing", growing},
+		{"random", random},
+	}
+
+	for _, filter := range filters {
+		for _, tt := range tests {
+			t.Run(filter.name+"/"+tt.name, func(t *testing.T) {
+				want := FilterUniqueElementsHashTable(tt.input)
+				got := filter.fn(tt.input)
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("%s(%v) = %v, want %v", filter.name, tt.input, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestAVLTreeStaysBalanced(t *testing.T) {
+	const numElems = 1 << 12
+	var root *treeNode
+	for i := 0; i < numElems; i++ {
+		inserted := false
+		root = insertNodeAVL(root, i, &inserted)
+	}
+	// An AVL tree of n nodes is at most about 1.44 log2(n) high.
+	if limit := int(1.45*math.Log2(numElems)) + 1; root.height > limit {
+		t.Errorf("height after %d sorted insertions = %d, want at most %d", numElems, root.height, limit)
+	}
+}